@@ -0,0 +1,7 @@
+data omglol_address example {
+  address = "example"
+}
+
+output "expires" {
+  value = formatdate("DD MMM YYYY", data.omglol_address.example.expires)
+}
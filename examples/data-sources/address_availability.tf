@@ -0,0 +1,17 @@
+data omglol_address_availability team {
+  address = "example-team"
+}
+
+resource omglol_purl team_home {
+  address = data.omglol_address_availability.team.address
+  name    = "home"
+  url     = "https://example.com"
+  listed  = true
+
+  lifecycle {
+    precondition {
+      condition     = !data.omglol_address_availability.team.available
+      error_message = "The address ${data.omglol_address_availability.team.punycode} has not been registered yet: ${data.omglol_address_availability.team.message}"
+    }
+  }
+}
@@ -0,0 +1,10 @@
+data omglol_addresses all {}
+
+resource omglol_purl home {
+  for_each = { for k, v in data.omglol_addresses.all.addresses_by_address : k => v if !v.expired }
+
+  address = each.key
+  name    = "home"
+  url     = "https://example.com"
+  listed  = true
+}
@@ -0,0 +1,3 @@
+data omglol_email_forwarding example {
+  address = "example"
+}
@@ -0,0 +1,5 @@
+data omglol_now_garden this {}
+
+output "now_pages" {
+  value = [for p in data.omglol_now_garden.this.pages : p.url]
+}
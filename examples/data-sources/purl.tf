@@ -0,0 +1,4 @@
+data omglol_purl example {
+  address = "example"
+  name = "rickroll"
+}
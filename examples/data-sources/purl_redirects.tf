@@ -0,0 +1,22 @@
+data omglol_purl_redirects netlify {
+  content  = file("${path.module}/_redirects")
+  format   = "netlify"
+  base_url = "https://example.com"
+}
+
+resource omglol_purl migrated {
+  for_each = data.omglol_purl_redirects.netlify.purls
+
+  address = "example"
+  name    = each.key
+  url     = each.value
+  listed  = false
+}
+
+data omglol_purl_redirects yaml {
+  format  = "yaml"
+  content = <<-EOT
+    blog: https://blog.example.com
+    cv: https://example.com/cv.pdf
+  EOT
+}
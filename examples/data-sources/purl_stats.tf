@@ -0,0 +1,8 @@
+data omglol_purl_stats all {
+  address = "example"
+}
+
+data omglol_purl_stats rickroll {
+  address = "example"
+  name = "rickroll"
+}
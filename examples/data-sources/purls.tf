@@ -1,3 +1,14 @@
 data omglol_purls example {
   address = "example"
-}
\ No newline at end of file
+}
+
+data omglol_purls listed_docs {
+  address = "example"
+  listed_only = true
+  name_prefix = "docs-"
+  url_regex = "^https://docs\\.example\\.com/"
+}
+
+output docs_link {
+  value = data.omglol_purls.listed_docs.purls_by_name["docs-home"].public_url
+}
@@ -0,0 +1,3 @@
+resource omglol_account_name this {
+  name = "Tommy Tester"
+}
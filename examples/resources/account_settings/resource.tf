@@ -1,4 +1,6 @@
 resource omglol_account_settings this {
   communication = "email_ok"
   date_format = "iso_8601"
+
+  restore_on_destroy = true
 }
@@ -0,0 +1,28 @@
+resource omglol_dns_mail_records example {
+  address = "example"
+  ttl = 300
+
+  mx = [
+    {
+      host = "mx1.example-mail.com"
+      priority = 10
+    },
+    {
+      host = "mx2.example-mail.com"
+      priority = 20
+    },
+  ]
+
+  spf_includes = ["_spf.example-mail.com"]
+  spf_policy = "-all"
+
+  dkim = {
+    selector = "mail"
+    public_key = "MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA..."
+  }
+
+  dmarc = {
+    policy = "quarantine"
+    rua = "dmarc-reports@example.com"
+  }
+}
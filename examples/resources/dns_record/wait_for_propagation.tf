@@ -0,0 +1,13 @@
+resource omglol_dns_record verification {
+  type = "TXT"
+  address = "example"
+  name = "_acme-challenge"
+  data = "verification-token"
+  ttl = 300
+
+  wait_for_propagation = {
+    resolver = "1.1.1.1"
+    timeout = "10m"
+    poll_interval = "15s"
+  }
+}
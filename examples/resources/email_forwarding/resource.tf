@@ -0,0 +1,4 @@
+resource omglol_email_forwarding example {
+  address      = "example"
+  destinations = ["someone@example.com", "someone-else@example.com"]
+}
@@ -0,0 +1,9 @@
+resource omglol_now_page example {
+  address = "example"
+  listed  = true
+  content = <<-EOT
+    # What I'm doing now
+
+    Writing Terraform for my omg.lol address.
+  EOT
+}
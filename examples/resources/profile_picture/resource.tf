@@ -0,0 +1,4 @@
+resource omglol_profile_picture example {
+  address     = "example"
+  source_file = "${path.module}/avatar.png"
+}
@@ -0,0 +1,14 @@
+resource omglol_purl_collection example {
+  address = "example"
+  remove_unmanaged = true
+
+  purls = {
+    rickroll = {
+      url = "https://www.youtube.com/watch?v=dQw4w9WgXcQ"
+    }
+    blog = {
+      url = "https://blog.example.com"
+      listed = true
+    }
+  }
+}
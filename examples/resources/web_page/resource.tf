@@ -0,0 +1,8 @@
+resource omglol_web_page example {
+  address = "example"
+  content = <<-EOT
+    # Example
+
+    Welcome to my omg.lol page!
+  EOT
+}
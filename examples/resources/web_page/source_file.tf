@@ -0,0 +1,8 @@
+resource omglol_web_page from_file {
+  address     = "example"
+  source_file = "${path.module}/profile.md"
+
+  template_vars = {
+    team = "Platform"
+  }
+}
@@ -0,0 +1,127 @@
+package omglol
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultAddressExpiryWarningDays is used when address_expiry_warning_days is not configured.
+const defaultAddressExpiryWarningDays = 30
+
+// addressExpiryChecker warns when an address used by a resource is about to expire. Lookups are cached per address,
+// so each address is only looked up once per provider run, however many resources use it.
+type addressExpiryChecker struct {
+	client      *omglol.Client
+	warningDays int64
+
+	mu      sync.Mutex
+	entries map[string]*addressExpiryEntry
+}
+
+// addressExpiryEntry is the cached expiration of an address. once ensures concurrent plans share a single lookup.
+type addressExpiryEntry struct {
+	once       sync.Once
+	expires    time.Time
+	expired    bool
+	willExpire bool
+	err        error
+}
+
+func newAddressExpiryChecker(client *omglol.Client, warningDays int64) *addressExpiryChecker {
+	return &addressExpiryChecker{
+		client:      client,
+		warningDays: warningDays,
+		entries:     map[string]*addressExpiryEntry{},
+	}
+}
+
+// lookup returns the cached expiration of an address, looking it up on first use.
+func (c *addressExpiryChecker) lookup(address string) *addressExpiryEntry {
+	c.mu.Lock()
+	entry, ok := c.entries[address]
+	if !ok {
+		entry = &addressExpiryEntry{}
+		c.entries[address] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		info, err := c.client.GetAddressInfo(address)
+		if err != nil {
+			entry.err = err
+			return
+		}
+		entry.expired = info.Expiration.Expired
+		entry.willExpire = info.Expiration.WillExpire
+		if info.Expiration.UnixEpochTime != 0 {
+			entry.expires = time.Unix(info.Expiration.UnixEpochTime, 0).UTC()
+		}
+	})
+
+	return entry
+}
+
+// check returns a warning if the address expires within the configured number of days. Warnings are disabled when
+// warningDays is 0. Lookup failures are logged rather than reported, so they never block a plan.
+func (c *addressExpiryChecker) check(ctx context.Context, address types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c == nil || c.warningDays <= 0 || address.IsNull() || address.IsUnknown() {
+		return diags
+	}
+
+	entry := c.lookup(address.ValueString())
+	if entry.err != nil {
+		tflog.Debug(ctx, "Unable to look up address expiration", map[string]any{"address": address.ValueString(), "error": entry.err.Error()})
+		return diags
+	}
+
+	if entry.expired {
+		diags.AddAttributeWarning(
+			path.Root("address"),
+			"Address Has Expired",
+			fmt.Sprintf("The omg.lol address %s has expired. Resources using it will stop working until it is renewed.", address.ValueString()),
+		)
+		return diags
+	}
+
+	if !entry.willExpire || entry.expires.IsZero() {
+		return diags
+	}
+
+	remaining := time.Until(entry.expires)
+	if remaining <= time.Duration(c.warningDays)*24*time.Hour {
+		diags.AddAttributeWarning(
+			path.Root("address"),
+			"Address Expires Soon",
+			fmt.Sprintf("The omg.lol address %s expires on %s, in %d day(s). Resources using it will stop working unless it is renewed.",
+				address.ValueString(), entry.expires.Format(time.RFC3339), int64(remaining.Hours()/24)),
+		)
+	}
+
+	return diags
+}
+
+// checkPlanAddressExpiry runs the expiry check against the address attribute of a planned resource. Nothing is
+// checked when the resource is being destroyed.
+func checkPlanAddressExpiry(ctx context.Context, checker *addressExpiryChecker, plan tfsdk.Plan) diag.Diagnostics {
+	if plan.Raw.IsNull() {
+		return nil
+	}
+
+	var address types.String
+	diags := plan.GetAttribute(ctx, path.Root("address"), &address)
+	if diags.HasError() {
+		return diags
+	}
+
+	return checker.check(ctx, address)
+}
@@ -0,0 +1,82 @@
+package omglol
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+)
+
+// apiResponse is the envelope wrapped around every omg.lol API response.
+type apiResponse struct {
+	Request struct {
+		StatusCode int64 `json:"status_code"`
+		Success    bool  `json:"success"`
+	} `json:"request"`
+	Response json.RawMessage `json:"response"`
+}
+
+// doAPIRequest calls an endpoint that the client library does not cover yet, using the client's host, HTTP client and
+// credentials. body, if not nil, is sent as JSON and the `response` member of the reply is decoded into out, if not
+// nil. Errors use the same `status: <code>, body: <body>` format as the client, so isNotFoundError works with them.
+func doAPIRequest(ctx context.Context, client *omglol.Client, method string, path string, body interface{}, out interface{}) error {
+	if body == nil {
+		return doRawAPIRequest(ctx, client, method, path, "", nil, out)
+	}
+
+	jsonData, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return doRawAPIRequest(ctx, client, method, path, "application/json", jsonData, out)
+}
+
+// doRawAPIRequest is like doAPIRequest, but sends body as it is with the given content type.
+func doRawAPIRequest(ctx context.Context, client *omglol.Client, method string, path string, contentType string, body []byte, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, client.HostURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+client.Auth.ApiKey)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	res, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("status: %d, body: %s", res.StatusCode, resBody)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	var r apiResponse
+	if err := json.Unmarshal(resBody, &r); err != nil {
+		return fmt.Errorf("could not decode response: %w", err)
+	}
+	if err := json.Unmarshal(r.Response, out); err != nil {
+		return fmt.Errorf("could not decode response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package omglol
+
+import (
+	"context"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &addressDataSource{}
+	_ datasource.DataSourceWithConfigure = &addressDataSource{}
+)
+
+func NewAddressDataSource() datasource.DataSource {
+	return &addressDataSource{}
+}
+
+type addressDataSource struct {
+	client *omglol.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *addressDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*omglol.Client)
+}
+
+func (d *addressDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_address"
+}
+
+func (d *addressDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve registration, expiration and verification details of an omg.lol address.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The omg.lol address to read.",
+			},
+			"owner": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The owner of the address.",
+			},
+			"registered": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC 3339 representation of the time that the address was registered. This can be used in conjunction with the [formatdate](https://developer.hashicorp.com/terraform/language/functions/formatdate) function.",
+			},
+			"expires": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC 3339 representation of the time that the address expires. Null if the address does not expire.",
+			},
+			"expired": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Returns `true` if the address has expired.",
+			},
+			"will_expire": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Returns `true` if the address is set to expire.",
+			},
+			"verified": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Returns `true` if the address is verified.",
+			},
+			"message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A human readable summary of the address, as returned by the API.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+type addressSingleDataSourceModel struct {
+	Address    types.String `tfsdk:"address"`
+	Owner      types.String `tfsdk:"owner"`
+	Registered types.String `tfsdk:"registered"`
+	Expires    types.String `tfsdk:"expires"`
+	Expired    types.Bool   `tfsdk:"expired"`
+	WillExpire types.Bool   `tfsdk:"will_expire"`
+	Verified   types.Bool   `tfsdk:"verified"`
+	Message    types.String `tfsdk:"message"`
+	ID         types.String `tfsdk:"id"`
+}
+
+func (d *addressDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state addressSingleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := d.client.GetAddressInfo(state.Address.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Address",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Owner = types.StringValue(info.Owner)
+	state.Registered = unixTimeValue(info.Registration.UnixEpochTime)
+	state.Expires = unixTimeValue(info.Expiration.UnixEpochTime)
+	state.Expired = types.BoolValue(info.Expiration.Expired)
+	state.WillExpire = types.BoolValue(info.Expiration.WillExpire)
+	state.Verified = types.BoolValue(info.Verification.Verified)
+	state.Message = types.StringValue(info.Message)
+	state.ID = types.StringValue(state.Address.ValueString())
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
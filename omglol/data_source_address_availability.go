@@ -0,0 +1,130 @@
+package omglol
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/idna"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &addressAvailabilityDataSource{}
+	_ datasource.DataSourceWithConfigure = &addressAvailabilityDataSource{}
+)
+
+func NewAddressAvailabilityDataSource() datasource.DataSource {
+	return &addressAvailabilityDataSource{}
+}
+
+type addressAvailabilityDataSource struct {
+	client *omglol.Client
+}
+
+// addressAvailability is the response of the address availability endpoint. The client's AddressAvailability type
+// does not include the punycode representation, so the endpoint is called directly.
+type addressAvailability struct {
+	Message      string `json:"message"`
+	Address      string `json:"address"`
+	Available    bool   `json:"available"`
+	Availability string `json:"availability"`
+	Punycode     string `json:"punycode"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *addressAvailabilityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*omglol.Client)
+}
+
+func (d *addressAvailabilityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_address_availability"
+}
+
+func (d *addressAvailabilityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Check whether an omg.lol address is available to register. The API does not report the price as a separate value, so the cost of an address is only available in the human readable `message`.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The candidate address to check.",
+			},
+			"available": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Returns `true` if the address can be registered.",
+			},
+			"availability": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The availability of the address as reported by the API, e.g. `available` or `unavailable`.",
+			},
+			"punycode": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The punycode representation of the address, which differs from `address` for addresses containing non-ASCII characters.",
+			},
+			"message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "A human readable explanation of the availability, as returned by the API. This is the only place pricing information is reported, where applicable. Its wording is not guaranteed, so avoid parsing it in conditions.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+type addressAvailabilityDataSourceModel struct {
+	Address      types.String `tfsdk:"address"`
+	Available    types.Bool   `tfsdk:"available"`
+	Availability types.String `tfsdk:"availability"`
+	Punycode     types.String `tfsdk:"punycode"`
+	Message      types.String `tfsdk:"message"`
+	ID           types.String `tfsdk:"id"`
+}
+
+func (d *addressAvailabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state addressAvailabilityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var availability addressAvailability
+	err := doAPIRequest(ctx, d.client, http.MethodGet, "/address/"+url.PathEscape(state.Address.ValueString())+"/availability", nil, &availability)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Address Availability",
+			err.Error(),
+		)
+		return
+	}
+
+	// Fall back to converting the address locally if the API did not return its punycode
+	punycode := availability.Punycode
+	if punycode == "" {
+		punycode, err = idna.ToASCII(state.Address.ValueString())
+		if err != nil {
+			punycode = state.Address.ValueString()
+		}
+	}
+
+	state.Available = types.BoolValue(availability.Available)
+	state.Availability = types.StringValue(availability.Availability)
+	state.Punycode = types.StringValue(punycode)
+	state.Message = types.StringValue(availability.Message)
+	state.ID = types.StringValue(state.Address.ValueString())
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,198 @@
+package omglol
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &addressesDataSource{}
+	_ datasource.DataSourceWithConfigure = &addressesDataSource{}
+)
+
+func NewAddressesDataSource() datasource.DataSource {
+	return &addressesDataSource{}
+}
+
+type addressesDataSource struct {
+	client *omglol.Client
+}
+
+// accountAddress is an address as returned by the account addresses endpoint. The client's Address type does not
+// include the verification status or preferences, so the endpoint is called directly.
+type accountAddress struct {
+	Address      string                     `json:"address"`
+	Message      string                     `json:"message"`
+	Punycode     string                     `json:"punycode"`
+	Registration omglol.AddressRegistration `json:"registration"`
+	Expiration   omglol.AddressExpiration   `json:"expiration"`
+	Verification *struct {
+		Message  string `json:"message"`
+		Verified bool   `json:"verified"`
+	} `json:"verification"`
+	Preferences map[string]json.RawMessage `json:"preferences"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *addressesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*omglol.Client)
+}
+
+func (d *addressesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_addresses"
+}
+
+func (d *addressesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all addresses associated with the account.",
+		Attributes: map[string]schema.Attribute{
+			"addresses": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The addresses associated with the account.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: addressesDataSourceEntryAttributes(),
+				},
+			},
+			"addresses_by_address": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The same addresses as `addresses`, keyed by address, e.g. for use with `for_each`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: addressesDataSourceEntryAttributes(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// addressesDataSourceEntryAttributes returns the attributes of a single address entry.
+func addressesDataSourceEntryAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"address": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The omg.lol address.",
+		},
+		"punycode": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The punycode representation of the address, which differs from `address` for addresses containing non-ASCII characters.",
+		},
+		"registered": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The RFC 3339 representation of the time that the address was registered.",
+		},
+		"expires": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The RFC 3339 representation of the time that the address expires. Null if the address does not expire.",
+		},
+		"expired": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Returns `true` if the address has expired.",
+		},
+		"will_expire": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Returns `true` if the address is set to expire.",
+		},
+		"verified": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Returns `true` if the address is verified. Null if the API did not report the verification status.",
+		},
+		"preferences": schema.MapAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "The preferences of the address. Values that are not strings are returned as JSON.",
+		},
+	}
+}
+
+type addressDataSourceModel struct {
+	Address     types.String            `tfsdk:"address"`
+	Punycode    types.String            `tfsdk:"punycode"`
+	Registered  types.String            `tfsdk:"registered"`
+	Expires     types.String            `tfsdk:"expires"`
+	Expired     types.Bool              `tfsdk:"expired"`
+	WillExpire  types.Bool              `tfsdk:"will_expire"`
+	Verified    types.Bool              `tfsdk:"verified"`
+	Preferences map[string]types.String `tfsdk:"preferences"`
+}
+
+type addressesDataSourceModel struct {
+	Addresses          []addressDataSourceModel          `tfsdk:"addresses"`
+	AddressesByAddress map[string]addressDataSourceModel `tfsdk:"addresses_by_address"`
+	ID                 types.String                      `tfsdk:"id"`
+}
+
+func (d *addressesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var addresses []accountAddress
+	err := doAPIRequest(ctx, d.client, http.MethodGet, "/account/"+url.PathEscape(d.client.Auth.Email)+"/addresses", nil, &addresses)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Addresses",
+			err.Error(),
+		)
+		return
+	}
+
+	state := addressesDataSourceModel{
+		Addresses:          []addressDataSourceModel{},
+		AddressesByAddress: map[string]addressDataSourceModel{},
+		ID:                 types.StringValue("_"),
+	}
+	for _, address := range addresses {
+
+		a := addressDataSourceModel{
+			Address:     types.StringValue(address.Address),
+			Punycode:    types.StringValue(address.Punycode),
+			Registered:  unixTimeValue(address.Registration.UnixEpochTime),
+			Expires:     unixTimeValue(address.Expiration.UnixEpochTime),
+			Expired:     types.BoolValue(address.Expiration.Expired),
+			WillExpire:  types.BoolValue(address.Expiration.WillExpire),
+			Verified:    types.BoolNull(),
+			Preferences: preferenceValues(address.Preferences),
+		}
+		if address.Punycode == "" {
+			a.Punycode = a.Address
+		}
+		if address.Verification != nil {
+			a.Verified = types.BoolValue(address.Verification.Verified)
+		}
+
+		state.Addresses = append(state.Addresses, a)
+		state.AddressesByAddress[address.Address] = a
+
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// preferenceValues converts address preferences into strings. Strings are kept as they are, other values are kept as JSON.
+func preferenceValues(preferences map[string]json.RawMessage) map[string]types.String {
+	values := map[string]types.String{}
+	for key, raw := range preferences {
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			values[key] = types.StringValue(s)
+		} else {
+			values[key] = types.StringValue(string(raw))
+		}
+	}
+	return values
+}
@@ -0,0 +1,96 @@
+package omglol
+
+import (
+	"context"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &emailForwardingDataSource{}
+	_ datasource.DataSourceWithConfigure = &emailForwardingDataSource{}
+)
+
+func NewEmailForwardingDataSource() datasource.DataSource {
+	return &emailForwardingDataSource{}
+}
+
+type emailForwardingDataSource struct {
+	client *omglol.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *emailForwardingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*omglol.Client)
+}
+
+func (d *emailForwardingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_forwarding"
+}
+
+func (d *emailForwardingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve where email sent to an omg.lol address is forwarded to.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The omg.lol address to read the forwarding of.",
+			},
+			"destinations": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses that email is forwarded to. Empty if forwarding is not set up.",
+			},
+			"email_address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The email address that is forwarded, e.g. `example@omg.lol`.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+type emailForwardingDataSourceModel struct {
+	Address      types.String   `tfsdk:"address"`
+	Destinations []types.String `tfsdk:"destinations"`
+	EmailAddress types.String   `tfsdk:"email_address"`
+	ID           types.String   `tfsdk:"id"`
+}
+
+func (d *emailForwardingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state emailForwardingDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forwarding, err := getEmailForwarding(ctx, d.client, state.Address.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Email Forwarding",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Destinations = stringValues(forwarding.destinations())
+	state.EmailAddress = types.StringValue(emailForwardingAddress(forwarding, state.Address.ValueString()))
+	state.ID = types.StringValue(state.Address.ValueString())
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,109 @@
+package omglol
+
+import (
+	"context"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nowGardenDataSource{}
+	_ datasource.DataSourceWithConfigure = &nowGardenDataSource{}
+)
+
+func NewNowGardenDataSource() datasource.DataSource {
+	return &nowGardenDataSource{}
+}
+
+type nowGardenDataSource struct {
+	client *omglol.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nowGardenDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*omglol.Client)
+}
+
+func (d *nowGardenDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_now_garden"
+}
+
+func (d *nowGardenDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the /now pages listed in the [Now Garden](https://now.garden).",
+		Attributes: map[string]schema.Attribute{
+			"pages": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The listed /now pages.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The omg.lol address the page belongs to.",
+						},
+						"url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The public URL of the page.",
+						},
+						"updated": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The RFC 3339 representation of the time the page was last updated.",
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+type nowGardenPageDataSourceModel struct {
+	Address types.String `tfsdk:"address"`
+	URL     types.String `tfsdk:"url"`
+	Updated types.String `tfsdk:"updated"`
+}
+
+type nowGardenDataSourceModel struct {
+	Pages []nowGardenPageDataSourceModel `tfsdk:"pages"`
+	ID    types.String                   `tfsdk:"id"`
+}
+
+func (d *nowGardenDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	garden, err := getNowGarden(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Now Garden",
+			err.Error(),
+		)
+		return
+	}
+
+	state := nowGardenDataSourceModel{
+		Pages: []nowGardenPageDataSourceModel{},
+		ID:    types.StringValue("_"),
+	}
+	for _, page := range garden {
+		state.Pages = append(state.Pages, nowGardenPageDataSourceModel{
+			Address: types.StringValue(page.Address),
+			URL:     types.StringValue(page.URL),
+			Updated: unixTimeValue(int64(page.Updated.UnixEpochTime)),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
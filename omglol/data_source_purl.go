@@ -0,0 +1,113 @@
+package omglol
+
+import (
+	"context"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pURLDataSource{}
+	_ datasource.DataSourceWithConfigure = &pURLDataSource{}
+)
+
+func NewPURLDataSource() datasource.DataSource {
+	return &pURLDataSource{}
+}
+
+type pURLDataSource struct {
+	client *omglol.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pURLDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*omglol.Client)
+}
+
+func (d *pURLDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_purl"
+}
+
+func (d *pURLDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve a single PURL of a given omg.lol address.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The omg.lol address to read the PURL from.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the PURL.",
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The url that is pointed to.",
+			},
+			"listed": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Returns `true` if listed on your `address`.url.lol page.",
+			},
+			"counter": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of time a PURL has been accessed. Null if the API does not report it.",
+			},
+			"public_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The public short link of the PURL, e.g. `https://example.url.lol/rickroll`.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique ID of the PURL, in the form `address/name`. Can be used for imports.",
+			},
+		},
+	}
+}
+
+type pURLSingleDataSourceModel struct {
+	Address   types.String `tfsdk:"address"`
+	Name      types.String `tfsdk:"name"`
+	URL       types.String `tfsdk:"url"`
+	Listed    types.Bool   `tfsdk:"listed"`
+	Counter   types.Int64  `tfsdk:"counter"`
+	PublicURL types.String `tfsdk:"public_url"`
+	ID        types.String `tfsdk:"id"`
+}
+
+func (d *pURLDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pURLSingleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	purl, err := d.client.GetPersistentURL(state.Address.ValueString(), state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read PURL",
+			err.Error(),
+		)
+		return
+	}
+
+	state.URL = types.StringValue(purl.URL)
+	state.Listed = types.BoolValue(purl.Listed)
+	state.Counter = pURLCounter(purl)
+	state.PublicURL = types.StringValue(pURLPublicURL(state.Address.ValueString(), state.Name.ValueString()))
+	state.ID = types.StringValue(pURLID(state.Address.ValueString(), state.Name.ValueString()))
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
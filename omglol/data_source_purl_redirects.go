@@ -0,0 +1,158 @@
+package omglol
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &pURLRedirectsDataSource{}
+)
+
+func NewPURLRedirectsDataSource() datasource.DataSource {
+	return &pURLRedirectsDataSource{}
+}
+
+// pURLRedirectsDataSource only parses its input, so it does not need the client.
+type pURLRedirectsDataSource struct{}
+
+func (d *pURLRedirectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_purl_redirects"
+}
+
+func (d *pURLRedirectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Parse redirect rules from another service into a map of PURL names to URLs, e.g. to migrate a Netlify `_redirects` file to `omglol_purl` resources. No requests are made to omg.lol.",
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The redirect rules to parse, e.g. read with the `file` function.",
+			},
+			"format": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The format of `content`. One of `" + strings.Join(redirectFormats, "`, `") + "`. When a name is defined more than once, the first `netlify` rule wins, matching how Netlify evaluates them, while later `csv` and `yaml` entries replace earlier ones.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(redirectFormats...),
+				},
+			},
+			"base_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "An absolute URL used to resolve relative targets, e.g. `https://example.com`. When omitted, rules with relative targets are skipped.",
+				Validators: []validator.String{
+					isAbsoluteURL(""),
+				},
+			},
+			"strict": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to fail when any rule cannot be converted to a PURL. Defaults to `false`, in which case a warning is shown for each skipped rule.",
+			},
+			"purls": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The parsed rules, mapping each PURL name to its URL. Leading and trailing slashes are removed from source paths.",
+			},
+			"issues": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The rules that were skipped, shadowed or replaced, with the reason.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"line": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The line of `content` the issue was found on.",
+						},
+						"message": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A description of the issue.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type pURLRedirectIssueModel struct {
+	Line    types.Int64  `tfsdk:"line"`
+	Message types.String `tfsdk:"message"`
+}
+
+type pURLRedirectsDataSourceModel struct {
+	Content types.String             `tfsdk:"content"`
+	Format  types.String             `tfsdk:"format"`
+	BaseURL types.String             `tfsdk:"base_url"`
+	Strict  types.Bool               `tfsdk:"strict"`
+	PURLs   map[string]types.String  `tfsdk:"purls"`
+	Issues  []pURLRedirectIssueModel `tfsdk:"issues"`
+}
+
+func (d *pURLRedirectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pURLRedirectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var base *url.URL
+	if !state.BaseURL.IsNull() {
+		var err error
+		base, err = url.Parse(state.BaseURL.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("base_url"),
+				"Invalid base_url",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	rules, issues, err := parseRedirectRules(state.Format.ValueString(), state.Content.ValueString(), base)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Unable to Parse Redirect Rules",
+			"Could not parse content as "+state.Format.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.PURLs = map[string]types.String{}
+	for _, rule := range rules {
+		state.PURLs[rule.Name] = types.StringValue(rule.URL)
+	}
+
+	state.Issues = []pURLRedirectIssueModel{}
+	for _, issue := range issues {
+		state.Issues = append(state.Issues, pURLRedirectIssueModel{
+			Line:    types.Int64Value(int64(issue.Line)),
+			Message: types.StringValue(issue.Message),
+		})
+
+		summary := fmt.Sprintf("Redirect Rule Issue on Line %d", issue.Line)
+		if state.Strict.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("content"), summary, issue.Message)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(path.Root("content"), summary, issue.Message)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
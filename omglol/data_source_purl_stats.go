@@ -0,0 +1,150 @@
+package omglol
+
+import (
+	"context"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pURLStatsDataSource{}
+	_ datasource.DataSourceWithConfigure = &pURLStatsDataSource{}
+)
+
+func NewPURLStatsDataSource() datasource.DataSource {
+	return &pURLStatsDataSource{}
+}
+
+type pURLStatsDataSource struct {
+	client *omglol.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pURLStatsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*omglol.Client)
+}
+
+func (d *pURLStatsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_purl_stats"
+}
+
+func (d *pURLStatsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieve the hit counters of one or all PURLs for a given omg.lol address.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The omg.lol address to read the counters from.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of a single PURL to read. When omitted, the counters of all PURLs are returned.",
+			},
+			"purls": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hit counter of each PURL.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the PURL.",
+						},
+						"counter": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of time a PURL has been accessed. Null if the API does not report it.",
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Unique ID of the PURL, in the form `address/name`.",
+						},
+					},
+				},
+			},
+			"total": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The sum of all returned counters, counting unreported ones as 0.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+type pURLStatDataSourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Counter types.Int64  `tfsdk:"counter"`
+	ID      types.String `tfsdk:"id"`
+}
+
+type pURLStatsDataSourceModel struct {
+	Address types.String              `tfsdk:"address"`
+	Name    types.String              `tfsdk:"name"`
+	PURLs   []pURLStatDataSourceModel `tfsdk:"purls"`
+	Total   types.Int64               `tfsdk:"total"`
+	ID      types.String              `tfsdk:"id"`
+}
+
+func (d *pURLStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pURLStatsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var pURLs []omglol.PersistentURL
+	if state.Name.IsNull() {
+		list, err := d.client.ListPersistentURLs(state.Address.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read PURLs",
+				err.Error(),
+			)
+			return
+		}
+		pURLs = *list
+		state.ID = types.StringValue(state.Address.ValueString())
+	} else {
+		purl, err := d.client.GetPersistentURL(state.Address.ValueString(), state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read PURL",
+				err.Error(),
+			)
+			return
+		}
+		pURLs = append(pURLs, *purl)
+		state.ID = types.StringValue(pURLID(state.Address.ValueString(), state.Name.ValueString()))
+	}
+
+	var total int64
+	state.PURLs = []pURLStatDataSourceModel{}
+	for _, purl := range pURLs {
+
+		counter := pURLCounter(&purl)
+		total += counter.ValueInt64()
+
+		state.PURLs = append(state.PURLs, pURLStatDataSourceModel{
+			Name:    types.StringValue(purl.Name),
+			Counter: counter,
+			ID:      types.StringValue(pURLID(state.Address.ValueString(), purl.Name)),
+		})
+
+	}
+	state.Total = types.Int64Value(total)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
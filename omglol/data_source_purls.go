@@ -2,10 +2,13 @@ package omglol
 
 import (
 	"context"
+	"regexp"
+	"strings"
 
 	"github.com/ejstreet/omglol-client-go/omglol"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -38,55 +41,91 @@ func (d *pURLsDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 
 func (d *pURLsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "List all PURLs for a given omg.lol address.",
+		MarkdownDescription: "List all PURLs for a given omg.lol address, optionally filtered.",
 		Attributes: map[string]schema.Attribute{
 			"address": schema.StringAttribute{
 				Required:            true,
 				MarkdownDescription: "The omg.lol address to read the purls from.",
 			},
+			"listed_only": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to only return PURLs listed on your `address`.url.lol page.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return PURLs whose name starts with this prefix.",
+			},
+			"url_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return PURLs whose url matches this [RE2](https://github.com/google/re2/wiki/Syntax) regular expression.",
+				Validators: []validator.String{
+					isRegex(),
+				},
+			},
 			"purls": schema.ListNestedAttribute{
 				Computed:            true,
-				MarkdownDescription: "A list of all the PURLs for the given address.",
+				MarkdownDescription: "A list of all the PURLs for the given address that match the filters.",
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The name of the PURL. The name field is how you will access your designated URL.",
-						},
-						"url": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The url that is pointed to.",
-						},
-						"listed": schema.BoolAttribute{
-							Computed:            true,
-							MarkdownDescription: "Returns `true` if listed on your `address`.url.lol page.",
-						},
-						"counter": schema.Int64Attribute{
-							Computed:            true,
-							MarkdownDescription: "The number of time a PURL has been accessed.",
-						},
-						"id": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Unique ID of the PURL. Can be used for imports.",
-						},
-					},
+					Attributes: pURLsDataSourceEntryAttributes(),
 				},
 			},
+			"purls_by_name": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The same PURLs as `purls`, keyed by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: pURLsDataSourceEntryAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// pURLsDataSourceEntryAttributes returns the attributes of a single PURL entry.
+func pURLsDataSourceEntryAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the PURL. The name field is how you will access your designated URL.",
+		},
+		"url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The url that is pointed to.",
+		},
+		"listed": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Returns `true` if listed on your `address`.url.lol page.",
+		},
+		"counter": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of time a PURL has been accessed. Null if the API does not report it.",
+		},
+		"public_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The public short link of the PURL, e.g. `https://example.url.lol/rickroll`.",
+		},
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Unique ID of the PURL, in the form `address/name`. Can be used for imports.",
 		},
 	}
 }
 
 type pURLDataSourceModel struct {
-	Name    types.String `tfsdk:"name"`
-	URL     types.String `tfsdk:"url"`
-	Listed  types.Bool   `tfsdk:"listed"`
-	Counter types.Int64  `tfsdk:"counter"`
-	ID      types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	URL       types.String `tfsdk:"url"`
+	Listed    types.Bool   `tfsdk:"listed"`
+	Counter   types.Int64  `tfsdk:"counter"`
+	PublicURL types.String `tfsdk:"public_url"`
+	ID        types.String `tfsdk:"id"`
 }
 
 type pURLsDataSourceModel struct {
-	Address types.String          `tfsdk:"address"`
-	PURLs   []pURLDataSourceModel `tfsdk:"purls"`
+	Address     types.String                   `tfsdk:"address"`
+	ListedOnly  types.Bool                     `tfsdk:"listed_only"`
+	NamePrefix  types.String                   `tfsdk:"name_prefix"`
+	URLRegex    types.String                   `tfsdk:"url_regex"`
+	PURLs       []pURLDataSourceModel          `tfsdk:"purls"`
+	PURLsByName map[string]pURLDataSourceModel `tfsdk:"purls_by_name"`
 }
 
 func (d *pURLsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -105,24 +144,43 @@ func (d *pURLsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	var urlRegex *regexp.Regexp
+	if !state.URLRegex.IsNull() {
+		urlRegex, err = regexp.Compile(state.URLRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid url_regex",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	state.PURLs = []pURLDataSourceModel{}
+	state.PURLsByName = map[string]pURLDataSourceModel{}
 	for _, purl := range *pURLs {
 
-		var counter int64
-		if purl.Counter != nil {
-			counter = *purl.Counter
-		} else {
-			counter = 0
+		if state.ListedOnly.ValueBool() && !purl.Listed {
+			continue
+		}
+		if !strings.HasPrefix(purl.Name, state.NamePrefix.ValueString()) {
+			continue
+		}
+		if urlRegex != nil && !urlRegex.MatchString(purl.URL) {
+			continue
 		}
 
 		p := pURLDataSourceModel{
-			ID:      types.StringValue(state.Address.ValueString() + "_" + purl.Name),
-			Name:    types.StringValue(purl.Name),
-			URL:     types.StringValue(purl.URL),
-			Counter: types.Int64Value(counter),
-			Listed:  types.BoolValue(purl.Listed),
+			ID:        types.StringValue(pURLID(state.Address.ValueString(), purl.Name)),
+			Name:      types.StringValue(purl.Name),
+			URL:       types.StringValue(purl.URL),
+			Counter:   pURLCounter(&purl),
+			Listed:    types.BoolValue(purl.Listed),
+			PublicURL: types.StringValue(pURLPublicURL(state.Address.ValueString(), purl.Name)),
 		}
 
 		state.PURLs = append(state.PURLs, p)
+		state.PURLsByName[purl.Name] = p
 
 	}
 
@@ -0,0 +1,110 @@
+package omglol
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+)
+
+// Ownership markers are TXT records stored next to each managed DNS record, similar to the external-dns TXT registry.
+// The marker data records which Terraform configuration owns the record, e.g.
+//
+//	heritage=terraform,terraform/owner=team-a,terraform/record=12345678
+const (
+	ownershipMarkerPrefix   = "_terraform"
+	ownershipMarkerHeritage = "heritage=terraform"
+	ownershipMarkerOwner    = "terraform/owner="
+	ownershipMarkerRecord   = "terraform/record="
+)
+
+// ownerIDPattern matches the owner IDs that can be written into a marker and read back, without the `,` and `=`
+// separators of the marker data.
+var ownerIDPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// ownershipMarker is a parsed ownership marker record.
+type ownershipMarker struct {
+	Record   omglol.DNSRecord
+	Owner    string
+	RecordID int64
+}
+
+// ownershipMarkerName returns the name of the marker record for a record name.
+func ownershipMarkerName(name string) string {
+	if name == "@" || name == "" {
+		return ownershipMarkerPrefix
+	}
+	return ownershipMarkerPrefix + "." + name
+}
+
+// ownershipMarkerData returns the contents of the marker record for a record owned by owner.
+func ownershipMarkerData(owner string, recordID int64) string {
+	return fmt.Sprintf("%s,%s%s,%s%d", ownershipMarkerHeritage, ownershipMarkerOwner, owner, ownershipMarkerRecord, recordID)
+}
+
+// parseOwnershipMarker extracts the owner and record ID from marker data. ok is false for any other TXT data.
+func parseOwnershipMarker(data string) (owner string, recordID int64, ok bool) {
+	fields := strings.Split(strings.Trim(data, `"`), ",")
+	if len(fields) != 3 || fields[0] != ownershipMarkerHeritage {
+		return "", 0, false
+	}
+	if !strings.HasPrefix(fields[1], ownershipMarkerOwner) || !strings.HasPrefix(fields[2], ownershipMarkerRecord) {
+		return "", 0, false
+	}
+
+	recordID, err := strconv.ParseInt(strings.TrimPrefix(fields[2], ownershipMarkerRecord), 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return strings.TrimPrefix(fields[1], ownershipMarkerOwner), recordID, true
+}
+
+// findOwnershipMarker looks up the marker belonging to a record. A nil marker is returned if the record has none.
+func findOwnershipMarker(client *omglol.Client, address string, recordID int64) (*ownershipMarker, error) {
+	records, err := client.ListDNSRecords(address)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range *records {
+		if record.Type != "TXT" {
+			continue
+		}
+		if owner, id, ok := parseOwnershipMarker(record.Data); ok && id == recordID {
+			return &ownershipMarker{Record: record, Owner: owner, RecordID: id}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// checkOwnership returns an error if the record is marked as owned by someone other than owner.
+// Records without a marker are not claimed by anyone and pass the check.
+func checkOwnership(client *omglol.Client, address string, recordID int64, owner string) (*ownershipMarker, error) {
+	marker, err := findOwnershipMarker(client, address, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	if marker != nil && marker.Owner != owner {
+		return marker, fmt.Errorf("DNS record %d on %s is owned by %q, not %q", recordID, address, marker.Owner, owner)
+	}
+
+	return marker, nil
+}
+
+// upsertOwnershipMarker writes the marker for a record, creating it or moving it to match the record's name.
+func upsertOwnershipMarker(client *omglol.Client, address string, marker *ownershipMarker, name string, ttl int64, recordID int64, owner string) error {
+	entry := omglol.NewDNSEntry("TXT", ownershipMarkerName(name), ownershipMarkerData(owner, recordID), ttl)
+
+	if marker == nil {
+		_, err := client.CreateDNSRecord(address, *entry)
+		return err
+	}
+
+	_, err := client.UpdateDNSRecord(address, *entry, marker.Record.ID)
+	return err
+}
@@ -0,0 +1,182 @@
+package omglol
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultPropagationTimeout      = "5m"
+	defaultPropagationPollInterval = "10s"
+)
+
+// dnsPropagationModel maps the wait_for_propagation attribute of the DNS record resource.
+type dnsPropagationModel struct {
+	Resolver     types.String `tfsdk:"resolver"`
+	Timeout      types.String `tfsdk:"timeout"`
+	PollInterval types.String `tfsdk:"poll_interval"`
+}
+
+var dnsPropagationAttrTypes = map[string]attr.Type{
+	"resolver":      types.StringType,
+	"timeout":       types.StringType,
+	"poll_interval": types.StringType,
+}
+
+// dnsExpectation describes the answer a resolver must return before a record is considered propagated.
+type dnsExpectation struct {
+	FQDN     string
+	Type     string
+	Data     string
+	Priority int64
+}
+
+// waitForDNSPropagation polls the given resolver until it answers for the record with the expected data,
+// or the timeout elapses.
+func waitForDNSPropagation(ctx context.Context, wait dnsPropagationModel, expected dnsExpectation) error {
+	if expected.Type == "CAA" {
+		return fmt.Errorf("waiting for CAA records is not supported")
+	}
+
+	timeout, err := time.ParseDuration(stringOrDefault(wait.Timeout, defaultPropagationTimeout))
+	if err != nil {
+		return err
+	}
+	interval, err := time.ParseDuration(stringOrDefault(wait.PollInterval, defaultPropagationPollInterval))
+	if err != nil {
+		return err
+	}
+
+	server := wait.Resolver.ValueString()
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "53")
+	}
+
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			d := net.Dialer{}
+			return d.DialContext(ctx, network, server)
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		found, err := lookupDNSExpectation(ctx, resolver, expected)
+		if found {
+			return nil
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Record %s %s not yet visible on %s: %v", expected.Type, expected.FQDN, server, err))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s record %s with data %q was not returned by %s within %s", expected.Type, expected.FQDN, expected.Data, server, timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// lookupDNSExpectation queries the resolver once and reports whether any answer matches the expectation.
+func lookupDNSExpectation(ctx context.Context, resolver *net.Resolver, expected dnsExpectation) (bool, error) {
+	switch expected.Type {
+	case "A", "AAAA":
+		network := "ip4"
+		if expected.Type == "AAAA" {
+			network = "ip6"
+		}
+		ips, err := resolver.LookupIP(ctx, network, expected.FQDN)
+		if err != nil {
+			return false, err
+		}
+		want := net.ParseIP(expected.Data)
+		for _, ip := range ips {
+			if ip.Equal(want) {
+				return true, nil
+			}
+		}
+	case "CNAME":
+		cname, err := resolver.LookupCNAME(ctx, expected.FQDN)
+		if err != nil {
+			return false, err
+		}
+		return sameHost(cname, expected.Data), nil
+	case "TXT":
+		txts, err := resolver.LookupTXT(ctx, expected.FQDN)
+		if err != nil {
+			return false, err
+		}
+		for _, txt := range txts {
+			if txt == strings.Trim(expected.Data, `"`) {
+				return true, nil
+			}
+		}
+	case "MX":
+		mxs, err := resolver.LookupMX(ctx, expected.FQDN)
+		if err != nil {
+			return false, err
+		}
+		for _, mx := range mxs {
+			if sameHost(mx.Host, expected.Data) && int64(mx.Pref) == expected.Priority {
+				return true, nil
+			}
+		}
+	case "NS":
+		nss, err := resolver.LookupNS(ctx, expected.FQDN)
+		if err != nil {
+			return false, err
+		}
+		for _, ns := range nss {
+			if sameHost(ns.Host, expected.Data) {
+				return true, nil
+			}
+		}
+	case "SRV":
+		_, srvs, err := resolver.LookupSRV(ctx, "", "", expected.FQDN)
+		if err != nil {
+			return false, err
+		}
+		// SRV data is entered as "weight port target", optionally preceded by the priority
+		want := strings.Fields(expected.Data)
+		for _, srv := range srvs {
+			got := []string{strconv.Itoa(int(srv.Weight)), strconv.Itoa(int(srv.Port)), srv.Target}
+			if len(want) == 4 && want[0] == strconv.Itoa(int(srv.Priority)) && equalSRVFields(want[1:], got) {
+				return true, nil
+			}
+			if len(want) == 3 && equalSRVFields(want, got) {
+				return true, nil
+			}
+		}
+	default:
+		return false, fmt.Errorf("waiting for %s records is not supported", expected.Type)
+	}
+
+	return false, fmt.Errorf("no matching answer")
+}
+
+func equalSRVFields(want []string, got []string) bool {
+	return want[0] == got[0] && want[1] == got[1] && sameHost(want[2], got[2])
+}
+
+func sameHost(a string, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+func stringOrDefault(v types.String, fallback string) string {
+	if v.IsNull() || v.IsUnknown() {
+		return fallback
+	}
+	return v.ValueString()
+}
@@ -0,0 +1,144 @@
+package omglol
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// testDNSServer is a local UDP DNS responder that answers A queries with an address chosen per query.
+type testDNSServer struct {
+	conn    net.PacketConn
+	queries int64
+	answer  func(query int64) net.IP
+	wg      sync.WaitGroup
+}
+
+// newTestDNSServer starts a responder on the loopback interface. It is stopped when the test finishes.
+func newTestDNSServer(t *testing.T, answer func(query int64) net.IP) *testDNSServer {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("could not start DNS server: %v", err)
+	}
+
+	s := &testDNSServer{conn: conn, answer: answer}
+	s.wg.Add(1)
+	go s.serve()
+
+	t.Cleanup(func() {
+		conn.Close()
+		s.wg.Wait()
+	})
+
+	return s
+}
+
+func (s *testDNSServer) serve() {
+	defer s.wg.Done()
+
+	buf := make([]byte, 512)
+	for {
+		n, addr, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		var p dnsmessage.Parser
+		header, err := p.Start(buf[:n])
+		if err != nil {
+			continue
+		}
+		question, err := p.Question()
+		if err != nil {
+			continue
+		}
+
+		b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: header.ID, Response: true, Authoritative: true})
+		b.EnableCompression()
+		_ = b.StartQuestions()
+		_ = b.Question(question)
+		_ = b.StartAnswers()
+		if question.Type == dnsmessage.TypeA {
+			var a [4]byte
+			copy(a[:], s.answer(atomic.AddInt64(&s.queries, 1)).To4())
+			_ = b.AResource(dnsmessage.ResourceHeader{Name: question.Name, Class: dnsmessage.ClassINET, TTL: 60}, dnsmessage.AResource{A: a})
+		}
+		msg, err := b.Finish()
+		if err != nil {
+			continue
+		}
+
+		_, _ = s.conn.WriteTo(msg, addr)
+	}
+}
+
+func testDNSPropagation(server *testDNSServer, timeout string) dnsPropagationModel {
+	return dnsPropagationModel{
+		Resolver:     types.StringValue(server.conn.LocalAddr().String()),
+		Timeout:      types.StringValue(timeout),
+		PollInterval: types.StringValue("20ms"),
+	}
+}
+
+var testDNSExpectation = dnsExpectation{
+	FQDN: "www.example.omg.lol.",
+	Type: "A",
+	Data: "192.0.2.1",
+}
+
+func TestWaitForDNSPropagationMatch(t *testing.T) {
+	server := newTestDNSServer(t, func(int64) net.IP {
+		return net.ParseIP("192.0.2.1")
+	})
+
+	err := waitForDNSPropagation(context.Background(), testDNSPropagation(server, "5s"), testDNSExpectation)
+	if err != nil {
+		t.Fatalf("expected the record to be found, got: %v", err)
+	}
+	if got := atomic.LoadInt64(&server.queries); got != 1 {
+		t.Errorf("expected 1 query, got %d", got)
+	}
+}
+
+func TestWaitForDNSPropagationConverges(t *testing.T) {
+	// The first answers are stale, as if the resolver had cached the previous data
+	server := newTestDNSServer(t, func(query int64) net.IP {
+		if query < 3 {
+			return net.ParseIP("192.0.2.99")
+		}
+		return net.ParseIP("192.0.2.1")
+	})
+
+	err := waitForDNSPropagation(context.Background(), testDNSPropagation(server, "5s"), testDNSExpectation)
+	if err != nil {
+		t.Fatalf("expected the record to be found, got: %v", err)
+	}
+	if got := atomic.LoadInt64(&server.queries); got != 3 {
+		t.Errorf("expected 3 queries, got %d", got)
+	}
+}
+
+func TestWaitForDNSPropagationTimeout(t *testing.T) {
+	server := newTestDNSServer(t, func(int64) net.IP {
+		return net.ParseIP("192.0.2.99")
+	})
+
+	err := waitForDNSPropagation(context.Background(), testDNSPropagation(server, "200ms"), testDNSExpectation)
+	if err == nil {
+		t.Fatal("expected a timeout error, got none")
+	}
+	if !strings.Contains(err.Error(), "was not returned by") {
+		t.Errorf("expected a timeout error, got: %v", err)
+	}
+	if got := atomic.LoadInt64(&server.queries); got < 2 {
+		t.Errorf("expected the resolver to be polled more than once, got %d queries", got)
+	}
+}
@@ -0,0 +1,58 @@
+package omglol
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+)
+
+// emailForwarding is the forwarding configuration of an address. The client library does not cover the email
+// endpoints yet, so they are called directly.
+type emailForwarding struct {
+	Message           string   `json:"message"`
+	DestinationString string   `json:"destination_string"`
+	DestinationArray  []string `json:"destination_array"`
+	Address           string   `json:"address"`
+	EmailAddress      string   `json:"email_address"`
+}
+
+// destinations returns the forwarding destinations, falling back to the comma separated string if the array is absent.
+func (f *emailForwarding) destinations() []string {
+	if f.DestinationArray != nil {
+		return f.DestinationArray
+	}
+
+	destinations := []string{}
+	for _, d := range strings.Split(f.DestinationString, ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			destinations = append(destinations, d)
+		}
+	}
+	return destinations
+}
+
+// emailForwardingAddress returns the email address that is forwarded, e.g. `example@omg.lol`.
+func emailForwardingAddress(f *emailForwarding, address string) string {
+	if f.EmailAddress != "" {
+		return f.EmailAddress
+	}
+	return address + "@omg.lol"
+}
+
+func getEmailForwarding(ctx context.Context, client *omglol.Client, address string) (*emailForwarding, error) {
+	var f emailForwarding
+	err := doAPIRequest(ctx, client, http.MethodGet, "/address/"+url.PathEscape(address)+"/email", nil, &f)
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// setEmailForwarding replaces the forwarding destinations of an address. An empty list turns off forwarding.
+func setEmailForwarding(ctx context.Context, client *omglol.Client, address string, destinations []string) error {
+	body := map[string]string{"destination": strings.Join(destinations, ", ")}
+	return doAPIRequest(ctx, client, http.MethodPost, "/address/"+url.PathEscape(address)+"/email", body, nil)
+}
@@ -0,0 +1,102 @@
+package omglol
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+)
+
+// nowPage is the /now page of an address. The client library does not cover the now endpoints yet, so they are
+// called directly.
+type nowPage struct {
+	Content string          `json:"content"`
+	Updated flexInt         `json:"updated"`
+	Listed  json.RawMessage `json:"listed"`
+}
+
+// listed reports whether the page is listed in the Now Garden. The API returns this as either a number or a boolean.
+func (p *nowPage) listed() bool {
+	var b bool
+	if err := json.Unmarshal(p.Listed, &b); err == nil {
+		return b
+	}
+
+	var s string
+	if err := json.Unmarshal(p.Listed, &s); err == nil {
+		n, _ := strconv.Atoi(s)
+		return n != 0
+	}
+
+	var n int64
+	if err := json.Unmarshal(p.Listed, &n); err == nil {
+		return n != 0
+	}
+
+	return false
+}
+
+// flexInt is a number the API returns either as a JSON number or as a string.
+type flexInt int64
+
+func (i *flexInt) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		if s == "" {
+			*i = 0
+			return nil
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		*i = flexInt(n)
+		return err
+	}
+
+	var n int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*i = flexInt(n)
+	return nil
+}
+
+// nowGardenEntry is a listed /now page.
+type nowGardenEntry struct {
+	Address string `json:"address"`
+	URL     string `json:"url"`
+	Updated struct {
+		UnixEpochTime flexInt `json:"unix_epoch_time"`
+	} `json:"updated"`
+}
+
+func getNowPage(ctx context.Context, client *omglol.Client, address string) (*nowPage, error) {
+	var r struct {
+		Now nowPage `json:"now"`
+	}
+	err := doAPIRequest(ctx, client, http.MethodGet, "/address/"+url.PathEscape(address)+"/now", nil, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r.Now, nil
+}
+
+func setNowPage(ctx context.Context, client *omglol.Client, address string, content string, listed bool) error {
+	body := map[string]interface{}{"content": content, "listed": 0}
+	if listed {
+		body["listed"] = 1
+	}
+	return doAPIRequest(ctx, client, http.MethodPost, "/address/"+url.PathEscape(address)+"/now", body, nil)
+}
+
+func getNowGarden(ctx context.Context, client *omglol.Client) ([]nowGardenEntry, error) {
+	var r struct {
+		Garden []nowGardenEntry `json:"garden"`
+	}
+	err := doAPIRequest(ctx, client, http.MethodGet, "/now/garden", nil, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.Garden, nil
+}
@@ -3,13 +3,16 @@ package omglol
 import (
 	"context"
 	"os"
+	"strconv"
 
 	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -50,6 +53,17 @@ func (p *omglolProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Sensitive:           true,
 				MarkdownDescription: "Pass this variable in the provider configuration, or alternatively set the `OMGLOL_API_KEY` environment variable. As this is a sensitive variable, it is recommended to set it as an environment variable.",
 			},
+			"owner_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Opt-in identifier for this Terraform configuration. When set, every `omglol_dns_record` gets a companion `TXT` ownership marker, and records whose marker names a different owner are never imported, modified or deleted. May only contain letters, digits, `_`, `.` and `-`. Pass this variable in the provider configuration, or alternatively set the `OMGLOL_OWNER_ID` environment variable.",
+			},
+			"address_expiry_warning_days": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Resources that take an `address` show a warning during plan when the address expires within this many days. Defaults to `" + strconv.Itoa(defaultAddressExpiryWarningDays) + "`, set to `0` to disable. Pass this variable in the provider configuration, or alternatively set the `OMGLOL_ADDRESS_EXPIRY_WARNING_DAYS` environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -59,6 +73,16 @@ type omglolProviderModel struct {
 	APIHost   types.String `tfsdk:"api_host"`
 	APIKey    types.String `tfsdk:"api_key"`
 	UserEmail types.String `tfsdk:"user_email"`
+	OwnerID   types.String `tfsdk:"owner_id"`
+
+	AddressExpiryWarningDays types.Int64 `tfsdk:"address_expiry_warning_days"`
+}
+
+// omglolResourceData is made available to resources during their Configure method.
+type omglolResourceData struct {
+	client        *omglol.Client
+	ownerID       string
+	addressExpiry *addressExpiryChecker
 }
 
 // Configure prepares a omglol API client for data sources and resources.
@@ -101,6 +125,24 @@ func (p *omglolProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	if config.OwnerID.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("owner_id"),
+			"Unknown omglol Owner ID",
+			"The provider cannot configure ownership markers as there is an unknown configuration value for the owner_id. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the OMGLOL_OWNER_ID environment variable.",
+		)
+	}
+
+	if config.AddressExpiryWarningDays.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("address_expiry_warning_days"),
+			"Unknown omglol Address Expiry Warning Days",
+			"The provider cannot configure address expiry warnings as there is an unknown configuration value for the address_expiry_warning_days. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the OMGLOL_ADDRESS_EXPIRY_WARNING_DAYS environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -111,6 +153,21 @@ func (p *omglolProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	host := os.Getenv("OMGLOL_API_HOST")
 	api_key := os.Getenv("OMGLOL_API_KEY")
 	user_email := os.Getenv("OMGLOL_USER_EMAIL")
+	owner_id := os.Getenv("OMGLOL_OWNER_ID")
+
+	address_expiry_warning_days := int64(defaultAddressExpiryWarningDays)
+	if v := os.Getenv("OMGLOL_ADDRESS_EXPIRY_WARNING_DAYS"); v != "" {
+		days, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || days < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("address_expiry_warning_days"),
+				"Invalid omglol Address Expiry Warning Days",
+				"The OMGLOL_ADDRESS_EXPIRY_WARNING_DAYS environment variable must be a whole number of days, 0 or more, got: "+v,
+			)
+			return
+		}
+		address_expiry_warning_days = days
+	}
 
 	if !config.APIHost.IsNull() {
 		host = config.APIHost.ValueString()
@@ -126,6 +183,14 @@ func (p *omglolProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		user_email = config.UserEmail.ValueString()
 	}
 
+	if !config.OwnerID.IsNull() {
+		owner_id = config.OwnerID.ValueString()
+	}
+
+	if !config.AddressExpiryWarningDays.IsNull() {
+		address_expiry_warning_days = config.AddressExpiryWarningDays.ValueInt64()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -149,6 +214,15 @@ func (p *omglolProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	if owner_id != "" && !ownerIDPattern.MatchString(owner_id) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("owner_id"),
+			"Invalid omglol Owner ID",
+			"The provider cannot configure ownership markers as the owner_id may only contain letters, digits, '_', '.' and '-', got: "+owner_id+". "+
+				"Check the owner_id value in the configuration and the OMGLOL_OWNER_ID environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -156,6 +230,8 @@ func (p *omglolProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	ctx = tflog.SetField(ctx, "host", host)
 	ctx = tflog.SetField(ctx, "user_email", user_email)
 	ctx = tflog.SetField(ctx, "api_key", api_key)
+	ctx = tflog.SetField(ctx, "owner_id", owner_id)
+	ctx = tflog.SetField(ctx, "address_expiry_warning_days", address_expiry_warning_days)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "api_key")
 
 	tflog.Debug(ctx, "Creating omg.lol client")
@@ -175,7 +251,11 @@ func (p *omglolProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	// Make the omglol client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.ResourceData = &omglolResourceData{
+		client:        client,
+		ownerID:       owner_id,
+		addressExpiry: newAddressExpiryChecker(client, address_expiry_warning_days),
+	}
 
 	tflog.Info(ctx, "Configured omg.lol client", map[string]any{"success": true})
 }
@@ -184,16 +264,31 @@ func (p *omglolProvider) Configure(ctx context.Context, req provider.ConfigureRe
 func (p *omglolProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAccountInfoDataSource,
+		NewAddressDataSource,
+		NewAddressAvailabilityDataSource,
+		NewAddressesDataSource,
 		NewDnsRecordsDataSource,
+		NewEmailForwardingDataSource,
+		NewNowGardenDataSource,
+		NewPURLDataSource,
+		NewPURLRedirectsDataSource,
 		NewPURLsDataSource,
+		NewPURLStatsDataSource,
 	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *omglolProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAccountNameResource,
 		NewAccountSettingsResource,
+		NewDNSMailRecordsResource,
 		NewDNSRecordResource,
+		NewEmailForwardingResource,
+		NewNowPageResource,
+		NewProfilePictureResource,
 		NewPURLResource,
+		NewPURLCollectionResource,
+		NewWebPageResource,
 	}
 }
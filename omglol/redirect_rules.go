@@ -0,0 +1,233 @@
+package omglol
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Supported redirect rule formats.
+const (
+	redirectFormatNetlify = "netlify"
+	redirectFormatCSV     = "csv"
+	redirectFormatYAML    = "yaml"
+)
+
+var redirectFormats = []string{redirectFormatNetlify, redirectFormatCSV, redirectFormatYAML}
+
+// redirectRule is a single redirect that can be expressed as a PURL.
+type redirectRule struct {
+	Line int
+	Name string
+	URL  string
+}
+
+// redirectIssue describes a line that could not be turned into a PURL.
+type redirectIssue struct {
+	Line    int
+	Message string
+}
+
+// redirectParser collects the rules and issues found while parsing a redirect file.
+type redirectParser struct {
+	base   *url.URL
+	rules  []redirectRule
+	issues []redirectIssue
+	seen   map[string]int
+	// firstWins keeps the first rule for a name, for formats that are evaluated top to bottom
+	firstWins bool
+}
+
+// parseRedirectRules parses content in the given format. Lines that cannot be expressed as a PURL are returned as
+// issues; an error is only returned when the content as a whole cannot be parsed. Relative targets are resolved
+// against base, if set.
+func parseRedirectRules(format string, content string, base *url.URL) ([]redirectRule, []redirectIssue, error) {
+	p := &redirectParser{base: base, seen: map[string]int{}}
+
+	var err error
+	switch format {
+	case redirectFormatNetlify:
+		p.firstWins = true
+		p.parseNetlify(content)
+	case redirectFormatCSV:
+		err = p.parseCSV(content)
+	case redirectFormatYAML:
+		err = p.parseYAML(content)
+	default:
+		err = fmt.Errorf("unsupported format %q", format)
+	}
+
+	return p.rules, p.issues, err
+}
+
+// parseNetlify parses a Netlify `_redirects` file. Rules are matched top to bottom, so when a name is defined more than
+// once the first rule is kept, e.g.
+//
+//	# comment
+//	/blog    https://blog.example.com    301
+func (p *redirectParser) parseNetlify(content string) {
+	for i, line := range strings.Split(content, "\n") {
+		lineNumber := i + 1
+
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			p.issue(lineNumber, "expected a source path and a target URL")
+			continue
+		}
+		// A query parameter condition, e.g. `/store id=:id /blog/:id 301`, comes before the target, which is always a URL
+		// or a path, so a target with a query string is not mistaken for one
+		if strings.Contains(fields[1], "=") && !strings.Contains(fields[1], "://") && !strings.HasPrefix(fields[1], "/") {
+			p.issue(lineNumber, "query parameter matching is not supported by PURLs")
+			continue
+		}
+		if len(fields) > 2 {
+			status := strings.TrimSuffix(fields[2], "!")
+			code, err := strconv.Atoi(status)
+			if err != nil {
+				p.issue(lineNumber, fmt.Sprintf("expected a status code after the target URL, got %q", fields[2]))
+				continue
+			}
+			if code < 300 || code > 399 {
+				p.issue(lineNumber, fmt.Sprintf("status %d is not a redirect", code))
+				continue
+			}
+			if len(fields) > 3 {
+				p.issue(lineNumber, "conditions are not supported by PURLs")
+				continue
+			}
+		}
+
+		p.add(lineNumber, fields[0], fields[1])
+	}
+}
+
+// parseCSV parses `name,url` records. A header row starting with `name` is skipped, as are lines starting with `#`.
+func (p *redirectParser) parseCSV(content string) error {
+	r := csv.NewReader(strings.NewReader(content))
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	first := true
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				p.issue(parseErr.Line, parseErr.Err.Error())
+				continue
+			}
+			return err
+		}
+
+		lineNumber, _ := r.FieldPos(0)
+		if first {
+			first = false
+			if strings.EqualFold(strings.TrimSpace(record[0]), "name") {
+				continue
+			}
+		}
+
+		if len(record) != 2 {
+			p.issue(lineNumber, fmt.Sprintf("expected 2 fields, `name,url`, got %d", len(record)))
+			continue
+		}
+
+		p.add(lineNumber, record[0], record[1])
+	}
+}
+
+// parseYAML parses a map of names to URLs, e.g.
+//
+//	blog: https://blog.example.com
+func (p *redirectParser) parseYAML(content string) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return err
+	}
+
+	// An empty document has no content
+	if len(doc.Content) == 0 {
+		return nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expected a map of names to URLs", root.Line)
+	}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		if key.Kind != yaml.ScalarNode || value.Kind != yaml.ScalarNode {
+			p.issue(key.Line, "expected a name mapped to a URL")
+			continue
+		}
+
+		p.add(key.Line, key.Value, value.Value)
+	}
+
+	return nil
+}
+
+// add normalises a source and target into a rule, or records an issue if they cannot be used for a PURL.
+func (p *redirectParser) add(line int, source string, target string) {
+	name := strings.Trim(strings.TrimSpace(source), "/")
+	if name == "" {
+		p.issue(line, "the root path cannot be a PURL")
+		return
+	}
+	if strings.ContainsAny(name, "*:") {
+		p.issue(line, fmt.Sprintf("placeholders and splats are not supported by PURLs, got %q", source))
+		return
+	}
+	if len(name) > pURLNameMaxLength || !pURLNameRegexp.MatchString(name) {
+		p.issue(line, fmt.Sprintf("%q is not a valid PURL name, names may only contain letters, numbers, `-` and `_`, up to %d characters", name, pURLNameMaxLength))
+		return
+	}
+
+	u, err := url.Parse(strings.TrimSpace(target))
+	if err != nil {
+		p.issue(line, fmt.Sprintf("invalid target URL: %s", err.Error()))
+		return
+	}
+	if !u.IsAbs() && p.base != nil {
+		u = p.base.ResolveReference(u)
+	}
+	if !u.IsAbs() || (u.Host == "" && u.Opaque == "") {
+		p.issue(line, fmt.Sprintf("target %q is not an absolute URL, set base_url to resolve relative targets", target))
+		return
+	}
+
+	if previous, ok := p.seen[name]; ok && p.firstWins {
+		p.issue(line, fmt.Sprintf("%q is shadowed by the rule on line %d, which matches first", name, previous))
+		return
+	} else if ok {
+		p.issue(line, fmt.Sprintf("%q was already defined on line %d, this definition replaces it", name, previous))
+		for i := range p.rules {
+			if p.rules[i].Name == name {
+				p.rules[i] = redirectRule{Line: line, Name: name, URL: u.String()}
+			}
+		}
+	} else {
+		p.rules = append(p.rules, redirectRule{Line: line, Name: name, URL: u.String()})
+	}
+	p.seen[name] = line
+}
+
+func (p *redirectParser) issue(line int, message string) {
+	p.issues = append(p.issues, redirectIssue{Line: line, Message: message})
+}
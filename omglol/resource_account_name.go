@@ -0,0 +1,206 @@
+package omglol
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &accountNameResource{}
+	_ resource.ResourceWithConfigure   = &accountNameResource{}
+	_ resource.ResourceWithImportState = &accountNameResource{}
+)
+
+// NewAccountNameResource is a helper function to simplify the provider implementation.
+func NewAccountNameResource() resource.Resource {
+	return &accountNameResource{}
+}
+
+// accountNameResource is the resource implementation.
+type accountNameResource struct {
+	client *omglol.Client
+}
+
+// accountNameResourceModel maps the resource schema data.
+type accountNameResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	ID          types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *accountNameResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_account_name"
+}
+
+// Schema defines the schema for the resource.
+func (r *accountNameResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the display name of your omg.lol account, as returned by the `omglol_account_info` data source.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name associated with the account.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC 3339 representation of the time the name was last changed by Terraform.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *accountNameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan accountNameResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set account name
+	err := r.setAccountName(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Setting Account Name",
+			"Could not set account name, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = lastUpdatedNow()
+	plan.ID = types.StringValue("_")
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *accountNameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state accountNameResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed account name from omg.lol, as shown by the omglol_account_info data source
+	account, err := r.client.GetAccountInfo()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account Name",
+			"Could not read account name: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite name with refreshed state
+	state.Name = types.StringValue(account.Name)
+	state.ID = types.StringValue("_")
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *accountNameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan accountNameResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set account name
+	err := r.setAccountName(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Setting Account Name",
+			"Could not set account name, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = lastUpdatedNow()
+	plan.ID = types.StringValue("_")
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state. An account always has a name, so it is left unchanged.
+func (r *accountNameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *accountNameResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*omglolResourceData).client
+}
+
+// ImportState adopts the current account name. There is a single name per account, so any ID is accepted.
+func (r *accountNameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state accountNameResourceModel
+
+	// Get current account name from omg.lol
+	account, err := r.client.GetAccountInfo()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Account Name",
+			"Could not read account name: "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(account.Name)
+	state.LastUpdated = types.StringNull()
+	state.ID = types.StringValue("_")
+
+	// Set refreshed state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// setAccountName sets the account name. The client does not escape the name when building the request body, so the
+// endpoint is called directly.
+func (r *accountNameResource) setAccountName(ctx context.Context, name string) error {
+	body := map[string]string{"name": name}
+	return doAPIRequest(ctx, r.client, http.MethodPost, "/account/"+url.PathEscape(r.client.Auth.Email)+"/name", body, nil)
+}
@@ -2,7 +2,7 @@ package omglol
 
 import (
 	"context"
-	"time"
+	"encoding/json"
 
 	"github.com/ejstreet/omglol-client-go/omglol"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -14,8 +14,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &accountSettingsResource{}
-	_ resource.ResourceWithConfigure = &accountSettingsResource{}
+	_ resource.Resource                = &accountSettingsResource{}
+	_ resource.ResourceWithConfigure   = &accountSettingsResource{}
+	_ resource.ResourceWithImportState = &accountSettingsResource{}
 )
 
 // NewAccountSettingsResource is a helper function to simplify the provider implementation.
@@ -28,12 +29,25 @@ type accountSettingsResource struct {
 	client *omglol.Client
 }
 
+// accountSettingsOriginalKey is the private state key holding the settings that were in place before Create.
+const accountSettingsOriginalKey = "original_settings"
+
+// accountSettingsDefaults are the settings of a new omg.lol account, applied on destroy when the original settings
+// are unknown.
+var accountSettingsDefaults = map[string]string{
+	"communication": "email_ok",
+	"date_format":   "iso_8601",
+}
+
 // accountSettingsResourceModel maps the resource schema data.
 type accountSettingsResourceModel struct {
-	Communication types.String `tfsdk:"communication"`
-	DateFormat    types.String `tfsdk:"date_format"`
-	LastUpdated   types.String `tfsdk:"last_updated"`
-	ID            types.String `tfsdk:"id"`
+	Owner            types.String `tfsdk:"owner"`
+	Communication    types.String `tfsdk:"communication"`
+	DateFormat       types.String `tfsdk:"date_format"`
+	WebEditor        types.String `tfsdk:"web_editor"`
+	RestoreOnDestroy types.Bool   `tfsdk:"restore_on_destroy"`
+	LastUpdated      types.String `tfsdk:"last_updated"`
+	ID               types.String `tfsdk:"id"`
 }
 
 // Metadata returns the resource type name.
@@ -44,23 +58,37 @@ func (r *accountSettingsResource) Metadata(_ context.Context, req resource.Metad
 // Schema defines the schema for the resource.
 func (r *accountSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the settings of your omg.lol account. Settings that are not configured are left as they are.",
 		Attributes: map[string]schema.Attribute{
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the account owner.",
+			},
 			"communication": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
 				MarkdownDescription: "Commuinication preferences. Valid values are `email_ok` and `email_not_ok`",
 				Validators: []validator.String{
 					stringvalidator.OneOf("email_ok", "email_not_ok"),
 				},
 			},
 			"date_format": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
 				MarkdownDescription: "Date preferences. Valid values are: `iso_8601` for *YYYY-MM-DD*, `dmy` for *DD-MM-YYYY*, and `mdy` for *MM-DD-YYYY*.",
 				Validators: []validator.String{
 					stringvalidator.OneOf("iso_8601", "dmy", "mdy"),
 				},
 			},
+			"web_editor": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The editor used when editing content on omg.lol.",
+			},
+			"restore_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to put back the settings that were in place before this resource was created when it is destroyed. Only the settings configured on this resource are restored. If the resource was imported or created with an earlier version of the provider, the omg.lol defaults are applied instead. Defaults to `false`, which leaves the settings unchanged on destroy.",
+			},
 			"last_updated": schema.StringAttribute{
-				Computed: true,
+				Computed:            true,
+				MarkdownDescription: "The RFC 3339 representation of the time the settings were last changed by Terraform. The API does not report modification times for account settings, so changes made outside of Terraform are not reflected.",
 			},
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -79,14 +107,26 @@ func (r *accountSettingsResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	// Generate API request body from plan, and compute owner data
-	settings := map[string]string{
-		"communication": plan.Communication.ValueString(),
-		"date_format":   plan.DateFormat.ValueString(),
+	// Capture the current settings, so they can be restored on destroy
+	original, err := r.client.GetAccountSettings()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Account Settings",
+			"Could not read Account Settings: "+err.Error(),
+		)
+		return
+	}
+	originalJSON, err := json.Marshal(accountSettingsMap(original))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Account Settings",
+			"Could not encode Account Settings: "+err.Error(),
+		)
+		return
 	}
 
 	// Set account settings
-	err := r.client.SetAccountSettings(settings)
+	err = r.setAccountSettings(&plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating settings",
@@ -95,7 +135,9 @@ func (r *accountSettingsResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, accountSettingsOriginalKey, originalJSON)...)
+
+	plan.LastUpdated = lastUpdatedNow()
 	plan.ID = types.StringValue("_")
 
 	// Set state to fully populated data
@@ -126,9 +168,19 @@ func (r *accountSettingsResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	// Overwrite settings with refreshed state
-	state.Communication = types.StringValue(*settings.Communication)
-	state.DateFormat = types.StringValue(*settings.DateFormat)
+	// Overwrite settings with refreshed state, leaving unmanaged settings null
+	if !state.Owner.IsNull() {
+		state.Owner = ownerValue(settings.Owner)
+	}
+	if !state.Communication.IsNull() {
+		state.Communication = stringPointerValue(settings.Communication)
+	}
+	if !state.DateFormat.IsNull() {
+		state.DateFormat = stringPointerValue(settings.DateFormat)
+	}
+	if !state.WebEditor.IsNull() {
+		state.WebEditor = stringPointerValue(settings.WebEditor)
+	}
 	state.ID = types.StringValue("_")
 
 	// Set refreshed state
@@ -149,14 +201,8 @@ func (r *accountSettingsResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	// Generate API request body from plan, and compute owner data
-	settings := map[string]string{
-		"communication": plan.Communication.ValueString(),
-		"date_format":   plan.DateFormat.ValueString(),
-	}
-
 	// Set account settings
-	err := r.client.SetAccountSettings(settings)
+	err := r.setAccountSettings(&plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating settings",
@@ -165,7 +211,7 @@ func (r *accountSettingsResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	plan.LastUpdated = lastUpdatedNow()
 	plan.ID = types.StringValue("_")
 
 	// Set state to fully populated data
@@ -177,7 +223,60 @@ func (r *accountSettingsResource) Update(ctx context.Context, req resource.Updat
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
+// The settings cannot be removed, so they are left as they are unless restore_on_destroy is set, in which case the
+// managed settings are restored.
 func (r *accountSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state accountSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.RestoreOnDestroy.ValueBool() {
+		return
+	}
+
+	originalJSON, diags := req.Private.GetKey(ctx, accountSettingsOriginalKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := accountSettingsDefaults
+	if originalJSON != nil {
+		var original map[string]string
+		if err := json.Unmarshal(originalJSON, &original); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Restoring Account Settings",
+				"Could not decode the original Account Settings: "+err.Error(),
+			)
+			return
+		}
+		settings = original
+	}
+
+	// Only restore the settings managed by this resource, leaving the others as they are
+	restore := map[string]string{}
+	for key, value := range accountSettingsValues(&state) {
+		if original, ok := settings[key]; ok && !value.IsNull() {
+			restore[key] = original
+		}
+	}
+	if len(restore) == 0 {
+		return
+	}
+
+	// Restore account settings
+	err := r.client.SetAccountSettings(restore)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Restoring Account Settings",
+			"Could not restore settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -186,5 +285,91 @@ func (r *accountSettingsResource) Configure(_ context.Context, req resource.Conf
 		return
 	}
 
-	r.client = req.ProviderData.(*omglol.Client)
+	r.client = req.ProviderData.(*omglolResourceData).client
+}
+
+// ImportState adopts the existing communication and date format settings. There is a single settings object per account,
+// so any ID is accepted.
+func (r *accountSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state accountSettingsResourceModel
+
+	// Get current account settings from omg.lol
+	settings, err := r.client.GetAccountSettings()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Account Settings",
+			"Could not read Account Settings: "+err.Error(),
+		)
+		return
+	}
+
+	// Only adopt communication and date_format. The other settings are not Computed, so importing them would plan to
+	// remove them again whenever they are not configured.
+	state.Owner = types.StringNull()
+	state.Communication = stringPointerValue(settings.Communication)
+	state.DateFormat = stringPointerValue(settings.DateFormat)
+	state.WebEditor = types.StringNull()
+	state.LastUpdated = types.StringNull()
+	state.ID = types.StringValue("_")
+
+	// Set refreshed state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// accountSettingsMap converts settings returned by the API into a request body, leaving out absent values.
+func accountSettingsMap(settings *omglol.AccountSettings) map[string]string {
+	m := map[string]string{}
+	if settings.Owner != "" {
+		m["owner"] = settings.Owner
+	}
+	if settings.Communication != nil {
+		m["communication"] = *settings.Communication
+	}
+	if settings.DateFormat != nil {
+		m["date_format"] = *settings.DateFormat
+	}
+	if settings.WebEditor != nil {
+		m["web_editor"] = *settings.WebEditor
+	}
+	return m
+}
+
+// setAccountSettings sends the configured settings to omg.lol. Settings which are null are not sent, so they are
+// left unchanged.
+func (r *accountSettingsResource) setAccountSettings(plan *accountSettingsResourceModel) error {
+	// Generate API request body from plan
+	settings := map[string]string{}
+	for key, value := range accountSettingsValues(plan) {
+		if !value.IsNull() {
+			settings[key] = value.ValueString()
+		}
+	}
+
+	if len(settings) == 0 {
+		return nil
+	}
+
+	return r.client.SetAccountSettings(settings)
+}
+
+// accountSettingsValues maps the API name of each setting to its value in the model.
+func accountSettingsValues(model *accountSettingsResourceModel) map[string]types.String {
+	return map[string]types.String{
+		"owner":         model.Owner,
+		"communication": model.Communication,
+		"date_format":   model.DateFormat,
+		"web_editor":    model.WebEditor,
+	}
+}
+
+// ownerValue converts the owner returned by the API, which is empty when absent.
+func ownerValue(owner string) types.String {
+	if owner == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(owner)
 }
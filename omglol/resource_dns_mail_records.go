@@ -0,0 +1,643 @@
+package omglol
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &dnsMailRecordsResource{}
+	_ resource.ResourceWithConfigure  = &dnsMailRecordsResource{}
+	_ resource.ResourceWithModifyPlan = &dnsMailRecordsResource{}
+)
+
+// Default SPF qualifier applied to the `all` mechanism when none is configured.
+const defaultSPFPolicy = "~all"
+
+// NewDNSMailRecordsResource is a helper function to simplify the provider implementation.
+func NewDNSMailRecordsResource() resource.Resource {
+	return &dnsMailRecordsResource{}
+}
+
+// dnsMailRecordsResource is the resource implementation.
+type dnsMailRecordsResource struct {
+	client        *omglol.Client
+	addressExpiry *addressExpiryChecker
+}
+
+// dnsMailRecordsResourceModel maps the resource schema data.
+type dnsMailRecordsResourceModel struct {
+	Address     types.String `tfsdk:"address"`
+	TTL         types.Int64  `tfsdk:"ttl"`
+	MX          types.List   `tfsdk:"mx"`
+	SPFIncludes types.List   `tfsdk:"spf_includes"`
+	SPFPolicy   types.String `tfsdk:"spf_policy"`
+	DKIM        types.Object `tfsdk:"dkim"`
+	DMARC       types.Object `tfsdk:"dmarc"`
+	Records     types.List   `tfsdk:"records"`
+	ID          types.String `tfsdk:"id"`
+}
+
+type dnsMailMXModel struct {
+	Host     types.String `tfsdk:"host"`
+	Priority types.Int64  `tfsdk:"priority"`
+}
+
+type dnsMailDKIMModel struct {
+	Selector  types.String `tfsdk:"selector"`
+	PublicKey types.String `tfsdk:"public_key"`
+}
+
+type dnsMailDMARCModel struct {
+	Policy types.String `tfsdk:"policy"`
+	RUA    types.String `tfsdk:"rua"`
+}
+
+type dnsMailRecordModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Type     types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	Data     types.String `tfsdk:"data"`
+	Priority types.Int64  `tfsdk:"priority"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+	FQDN     types.String `tfsdk:"fqdn"`
+}
+
+var dnsMailRecordAttrTypes = map[string]attr.Type{
+	"id":       types.Int64Type,
+	"type":     types.StringType,
+	"name":     types.StringType,
+	"data":     types.StringType,
+	"priority": types.Int64Type,
+	"ttl":      types.Int64Type,
+	"fqdn":     types.StringType,
+}
+
+// Metadata returns the resource type name.
+func (r *dnsMailRecordsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_mail_records"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsMailRecordsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the MX, SPF, DKIM and DMARC records needed to send and receive mail for an omg.lol address as a single unit.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Your omg.lol address to create the records for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The Time-To-Live (TTL) applied to every record.",
+			},
+			"mx": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The mail exchangers of your mail provider. One `MX` record is created on the apex for each entry.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The hostname of the mail exchanger.",
+						},
+						"priority": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "The priority of the mail exchanger.",
+						},
+					},
+				},
+			},
+			"spf_includes": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Domains to add as `include:` mechanisms in the SPF record, e.g. `_spf.google.com`. An SPF `TXT` record is created on the apex when this or `spf_policy` is set.",
+			},
+			"spf_policy": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The qualified `all` mechanism that ends the SPF record. Valid values are `-all`, `~all` and `?all`. Defaults to `" + defaultSPFPolicy + "`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("-all", "~all", "?all"),
+				},
+			},
+			"dkim": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The DKIM key published by your mail provider. Creates a `TXT` record named `<selector>._domainkey`.",
+				Attributes: map[string]schema.Attribute{
+					"selector": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The DKIM selector, e.g. `google`.",
+					},
+					"public_key": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The base64 encoded public key, used as the `p=` tag of the record.",
+					},
+				},
+			},
+			"dmarc": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The DMARC policy for the address. Creates a `TXT` record named `_dmarc`.",
+				Attributes: map[string]schema.Attribute{
+					"policy": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The DMARC policy. Valid values are `none`, `quarantine` and `reject`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("none", "quarantine", "reject"),
+						},
+					},
+					"rua": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Where aggregate reports are sent. A plain email address is prefixed with `mailto:`.",
+					},
+				},
+			},
+			"records": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The DNS records managed by this resource.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The record type.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The prefix attached before the address. `@` represents the apex.",
+						},
+						"data": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The data entered into the record.",
+						},
+						"priority": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The priority of the record. Only applies to MX records.",
+						},
+						"ttl": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The Time-To-Live (TTL) of the record.",
+						},
+						"fqdn": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The fully qualified domain name of the record. Made by combining DNS name, address, and omg.lol top-level.",
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsMailRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsMailRecordsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := expandDNSMailRecords(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, diags := r.applyDNSMailRecords(ctx, plan.Address.ValueString(), desired, nil)
+	resp.Diagnostics.Append(diags...)
+
+	// Record whatever was created, even on partial failure, so nothing is orphaned
+	plan.Records, diags = flattenDNSMailRecords(records)
+	resp.Diagnostics.Append(diags...)
+	plan.ID = types.StringValue(plan.Address.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *dnsMailRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsMailRecordsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current []dnsMailRecordModel
+	diags = state.Records.ElementsAs(ctx, &current, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed DNS records from omg.lol
+	tflog.Debug(ctx, fmt.Sprintf("Reading mail records from address: %s", state.Address.ValueString()))
+	existing, err := r.client.ListDNSRecords(state.Address.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS Records",
+			"Could not read DNS records: "+err.Error(),
+		)
+		return
+	}
+
+	byID := make(map[int64]omglol.DNSRecord, len(*existing))
+	for _, record := range *existing {
+		byID[record.ID] = record
+	}
+
+	// Drop records which were deleted outside of Terraform, so they are recreated on the next apply
+	var refreshed []omglol.DNSRecord
+	for _, c := range current {
+		if record, ok := byID[c.ID.ValueInt64()]; ok {
+			refreshed = append(refreshed, record)
+		}
+	}
+
+	state.Records, diags = flattenDNSMailRecords(refreshed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsMailRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state dnsMailRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current []dnsMailRecordModel
+	diags := state.Records.ElementsAs(ctx, &current, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := expandDNSMailRecords(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, diags := r.applyDNSMailRecords(ctx, plan.Address.ValueString(), desired, current)
+	resp.Diagnostics.Append(diags...)
+
+	plan.Records, diags = flattenDNSMailRecords(records)
+	resp.Diagnostics.Append(diags...)
+	plan.ID = types.StringValue(plan.Address.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsMailRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsMailRecordsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current []dnsMailRecordModel
+	diags = state.Records.ElementsAs(ctx, &current, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete every managed record
+	for _, c := range current {
+		err := r.client.DeleteDNSRecord(state.Address.ValueString(), c.ID.ValueInt64())
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting DNS Record",
+				fmt.Sprintf("Could not delete DNS record %d, unexpected error: %s", c.ID.ValueInt64(), err.Error()),
+			)
+		}
+	}
+}
+
+// ModifyPlan warns when the address is about to expire, and forces an update when the records in state have drifted
+// from the configuration.
+func (r *dnsMailRecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkPlanAddressExpiry(ctx, r.addressExpiry, req.Plan)...)
+
+	// Nothing to compare on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state dnsMailRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := expandDNSMailRecords(ctx, plan)
+	if diags.HasError() {
+		// Values are not yet known, the records will be recomputed during apply
+		return
+	}
+
+	var current []dnsMailRecordModel
+	resp.Diagnostics.Append(state.Records.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if dnsMailRecordsMatch(desired, current) {
+		plan.Records = state.Records
+	} else {
+		plan.Records = types.ListUnknown(types.ObjectType{AttrTypes: dnsMailRecordAttrTypes})
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsMailRecordsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*omglolResourceData)
+	r.client = data.client
+	r.addressExpiry = data.addressExpiry
+}
+
+// applyDNSMailRecords reconciles the desired records against the currently managed ones. Records of the same
+// type and name are updated in place where possible, so record IDs stay stable between applies. On error, the
+// returned records still include every current record that was not successfully replaced or deleted, so the state
+// keeps track of all records that exist.
+func (r *dnsMailRecordsResource) applyDNSMailRecords(ctx context.Context, address string, desired []omglol.DNSEntry, current []dnsMailRecordModel) ([]omglol.DNSRecord, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var records []omglol.DNSRecord
+
+	available := make(map[string][]dnsMailRecordModel)
+	for _, c := range current {
+		key := dnsMailRecordKey(c.Type.ValueString(), c.Name.ValueString())
+		available[key] = append(available[key], c)
+	}
+
+	// unprocessed returns the current records that have not been handled yet
+	unprocessed := func() []omglol.DNSRecord {
+		var remaining []omglol.DNSRecord
+		for _, c := range current {
+			for _, a := range available[dnsMailRecordKey(c.Type.ValueString(), c.Name.ValueString())] {
+				if a.ID.Equal(c.ID) {
+					remaining = append(remaining, dnsMailRecordFromModel(c))
+					break
+				}
+			}
+		}
+		return remaining
+	}
+
+	for _, entry := range desired {
+		key := dnsMailRecordKey(*entry.Type, *entry.Name)
+
+		if len(available[key]) > 0 {
+			c := available[key][0]
+			available[key] = available[key][1:]
+
+			tflog.Debug(ctx, fmt.Sprintf("Updating mail record %d on address: %s", c.ID.ValueInt64(), address))
+			record, err := r.client.UpdateDNSRecord(address, entry, c.ID.ValueInt64())
+			if err != nil {
+				diags.AddError(
+					"Error Updating DNS Record",
+					"Could not update DNS record, unexpected error: "+err.Error(),
+				)
+				records = append(records, dnsMailRecordFromModel(c))
+				return append(records, unprocessed()...), diags
+			}
+			records = append(records, *record)
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Creating %s mail record on address: %s", *entry.Type, address))
+		record, err := r.client.CreateDNSRecord(address, entry)
+		if err != nil {
+			diags.AddError(
+				"Error Creating DNS Record",
+				"Could not create DNS record, unexpected error: "+err.Error(),
+			)
+			return append(records, unprocessed()...), diags
+		}
+		records = append(records, *record)
+	}
+
+	// Remove records that are no longer part of the configuration, keeping those that could not be deleted
+	for _, c := range unprocessed() {
+		err := r.client.DeleteDNSRecord(address, c.ID)
+		if err != nil && !isNotFoundError(err) {
+			diags.AddError(
+				"Error Deleting DNS Record",
+				"Could not delete DNS record, unexpected error: "+err.Error(),
+			)
+			records = append(records, c)
+		}
+	}
+
+	return records, diags
+}
+
+// dnsMailRecordFromModel converts a record kept in state back into the form returned by the API.
+func dnsMailRecordFromModel(c dnsMailRecordModel) omglol.DNSRecord {
+	record := omglol.DNSRecord{
+		ID:   c.ID.ValueInt64(),
+		Type: c.Type.ValueString(),
+		Name: strings.TrimSuffix(c.FQDN.ValueString(), ".omg.lol"),
+		Data: c.Data.ValueString(),
+		TTL:  c.TTL.ValueInt64(),
+	}
+	if !c.Priority.IsNull() {
+		priority := c.Priority.ValueInt64()
+		record.Priority = &priority
+	}
+	return record
+}
+
+// expandDNSMailRecords builds the DNS entries described by the high level mail settings.
+func expandDNSMailRecords(ctx context.Context, plan dnsMailRecordsResourceModel) ([]omglol.DNSEntry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var entries []omglol.DNSEntry
+
+	if plan.TTL.IsUnknown() || plan.MX.IsUnknown() || plan.SPFIncludes.IsUnknown() || plan.SPFPolicy.IsUnknown() || plan.DKIM.IsUnknown() || plan.DMARC.IsUnknown() {
+		diags.AddError("Unknown Mail Settings", "The mail settings are not yet known.")
+		return nil, diags
+	}
+
+	ttl := plan.TTL.ValueInt64()
+
+	if !plan.MX.IsNull() {
+		var mx []dnsMailMXModel
+		diags.Append(plan.MX.ElementsAs(ctx, &mx, false)...)
+		for _, m := range mx {
+			if m.Host.IsUnknown() || m.Priority.IsUnknown() {
+				diags.AddError("Unknown Mail Settings", "The mail exchangers are not yet known.")
+				return nil, diags
+			}
+			entries = append(entries, *omglol.NewDNSEntry("MX", "@", m.Host.ValueString(), ttl, m.Priority.ValueInt64()))
+		}
+	}
+
+	if !plan.SPFIncludes.IsNull() || !plan.SPFPolicy.IsNull() {
+		var includes []types.String
+		if !plan.SPFIncludes.IsNull() {
+			diags.Append(plan.SPFIncludes.ElementsAs(ctx, &includes, false)...)
+		}
+
+		policy := defaultSPFPolicy
+		if !plan.SPFPolicy.IsNull() {
+			policy = plan.SPFPolicy.ValueString()
+		}
+
+		spf := []string{"v=spf1"}
+		for _, include := range includes {
+			if include.IsUnknown() {
+				diags.AddError("Unknown Mail Settings", "The SPF includes are not yet known.")
+				return nil, diags
+			}
+			spf = append(spf, "include:"+include.ValueString())
+		}
+		spf = append(spf, policy)
+
+		entries = append(entries, *omglol.NewDNSEntry("TXT", "@", strings.Join(spf, " "), ttl))
+	}
+
+	if !plan.DKIM.IsNull() {
+		var dkim dnsMailDKIMModel
+		diags.Append(plan.DKIM.As(ctx, &dkim, basetypes.ObjectAsOptions{})...)
+		if dkim.Selector.IsUnknown() || dkim.PublicKey.IsUnknown() {
+			diags.AddError("Unknown Mail Settings", "The DKIM settings are not yet known.")
+			return nil, diags
+		}
+
+		name := dkim.Selector.ValueString() + "._domainkey"
+		data := "v=DKIM1; k=rsa; p=" + dkim.PublicKey.ValueString()
+		entries = append(entries, *omglol.NewDNSEntry("TXT", name, data, ttl))
+	}
+
+	if !plan.DMARC.IsNull() {
+		var dmarc dnsMailDMARCModel
+		diags.Append(plan.DMARC.As(ctx, &dmarc, basetypes.ObjectAsOptions{})...)
+		if dmarc.Policy.IsUnknown() || dmarc.RUA.IsUnknown() {
+			diags.AddError("Unknown Mail Settings", "The DMARC settings are not yet known.")
+			return nil, diags
+		}
+
+		data := "v=DMARC1; p=" + dmarc.Policy.ValueString()
+		if !dmarc.RUA.IsNull() {
+			rua := dmarc.RUA.ValueString()
+			if !strings.Contains(rua, ":") {
+				rua = "mailto:" + rua
+			}
+			data += "; rua=" + rua
+		}
+		entries = append(entries, *omglol.NewDNSEntry("TXT", "_dmarc", data, ttl))
+	}
+
+	return entries, diags
+}
+
+// flattenDNSMailRecords converts API records into the computed records list.
+func flattenDNSMailRecords(records []omglol.DNSRecord) (types.List, diag.Diagnostics) {
+	elements := make([]attr.Value, 0, len(records))
+	for _, record := range records {
+		priority := types.Int64Null()
+		if record.Type == "MX" && record.Priority != nil {
+			priority = types.Int64Value(*record.Priority)
+		}
+
+		name := "@"
+		if i := strings.LastIndex(record.Name, "."); i != -1 {
+			name = record.Name[:i]
+		}
+
+		elements = append(elements, types.ObjectValueMust(dnsMailRecordAttrTypes, map[string]attr.Value{
+			"id":       types.Int64Value(record.ID),
+			"type":     types.StringValue(record.Type),
+			"name":     types.StringValue(name),
+			"data":     types.StringValue(record.Data),
+			"priority": priority,
+			"ttl":      types.Int64Value(record.TTL),
+			"fqdn":     types.StringValue(record.Name + ".omg.lol"),
+		}))
+	}
+
+	return types.ListValue(types.ObjectType{AttrTypes: dnsMailRecordAttrTypes}, elements)
+}
+
+// dnsMailRecordsMatch reports whether the managed records are exactly the desired ones, in the same order.
+func dnsMailRecordsMatch(desired []omglol.DNSEntry, current []dnsMailRecordModel) bool {
+	if len(desired) != len(current) {
+		return false
+	}
+
+	for i, entry := range desired {
+		c := current[i]
+		if c.Type.ValueString() != *entry.Type || c.Name.ValueString() != *entry.Name || c.Data.ValueString() != *entry.Data || c.TTL.ValueInt64() != *entry.TTL {
+			return false
+		}
+		if entry.Priority != nil && c.Priority.ValueInt64() != *entry.Priority {
+			return false
+		}
+	}
+
+	return true
+}
+
+func dnsMailRecordKey(recordType string, name string) string {
+	return recordType + "|" + name
+}
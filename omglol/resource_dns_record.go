@@ -8,6 +8,8 @@ import (
 
 	"github.com/ejstreet/omglol-client-go/omglol"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -22,6 +25,7 @@ import (
 var (
 	_ resource.Resource                = &dnsRecordResource{}
 	_ resource.ResourceWithConfigure   = &dnsRecordResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsRecordResource{}
 	_ resource.ResourceWithImportState = &dnsRecordResource{}
 )
 
@@ -32,7 +36,9 @@ func NewDNSRecordResource() resource.Resource {
 
 // dnsrecordResource is the resource implementation.
 type dnsRecordResource struct {
-	client *omglol.Client
+	client        *omglol.Client
+	ownerID       string
+	addressExpiry *addressExpiryChecker
 }
 
 // dnsrecordResourceModel maps the resource schema data.
@@ -47,6 +53,8 @@ type dnsRecordResourceModel struct {
 	FQDN      types.String `tfsdk:"fqdn"`
 	CreatedAt types.String `tfsdk:"created_at"`
 	UpdatedAt types.String `tfsdk:"updated_at"`
+
+	WaitForPropagation types.Object `tfsdk:"wait_for_propagation"`
 }
 
 // Metadata returns the resource type name.
@@ -96,6 +104,30 @@ func (r *dnsRecordResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:            true,
 				MarkdownDescription: "The fully qualified domain name of the record. Made by combining DNS name, address, and omg.lol top-level.",
 			},
+			"wait_for_propagation": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "When set, create and update operations wait until the given resolver returns the record's `fqdn` with the expected `data`. Useful when downstream resources, such as certificate or mail verification, depend on the record being visible. Not supported for `CAA` records.",
+				Attributes: map[string]schema.Attribute{
+					"resolver": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The DNS server to query, as `host` or `host:port`. Port `53` is used when omitted.",
+					},
+					"timeout": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "How long to wait for the record to appear, e.g. `2m`. Defaults to `" + defaultPropagationTimeout + "`.",
+						Validators: []validator.String{
+							isDuration(),
+						},
+					},
+					"poll_interval": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "How long to wait between queries, e.g. `5s`. Defaults to `" + defaultPropagationPollInterval + "`.",
+						Validators: []validator.String{
+							isDuration(),
+						},
+					},
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -154,6 +186,20 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Mark the new record as owned by this configuration
+	if r.ownerID != "" {
+		err = upsertOwnershipMarker(r.client, plan.Address.ValueString(), nil, plan.Name.ValueString(), plan.TTL.ValueInt64(), record.ID, r.ownerID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Ownership Marker",
+				"The DNS record was created, but its ownership marker could not be written: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(waitForDNSRecord(ctx, plan)...)
 }
 
 // Read resource information
@@ -236,6 +282,20 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		entry = omglol.NewDNSEntry(plan.Type.ValueString(), plan.Name.ValueString(), plan.Data.ValueString(), plan.TTL.ValueInt64())
 	}
 
+	// Refuse to modify records owned by another configuration
+	var marker *ownershipMarker
+	if r.ownerID != "" {
+		var err error
+		marker, err = checkOwnership(r.client, plan.Address.ValueString(), plan.ID.ValueInt64(), r.ownerID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating DNS Record",
+				"Refusing to update DNS record: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Update DNS Record
 	record, err := r.client.UpdateDNSRecord(plan.Address.ValueString(), *entry, plan.ID.ValueInt64())
 	if err != nil {
@@ -246,6 +306,18 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// Keep the ownership marker next to the record, adding one to records adopted through import
+	if r.ownerID != "" {
+		err = upsertOwnershipMarker(r.client, plan.Address.ValueString(), marker, plan.Name.ValueString(), plan.TTL.ValueInt64(), record.ID, r.ownerID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Ownership Marker",
+				"The DNS record was updated, but its ownership marker could not be written: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	plan.FQDN = types.StringValue(record.Name + ".omg.lol")
 	plan.CreatedAt = types.StringValue(record.CreatedAt)
 	plan.UpdatedAt = types.StringValue(record.UpdatedAt)
@@ -257,6 +329,8 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(waitForDNSRecord(ctx, plan)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -269,6 +343,30 @@ func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	// Refuse to delete records owned by another configuration
+	var marker *ownershipMarker
+	if r.ownerID != "" {
+		var err error
+		marker, err = checkOwnership(r.client, state.Address.ValueString(), state.ID.ValueInt64(), r.ownerID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting DNS Record",
+				"Refusing to delete DNS record: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		// The record may have been marked while owner_id was set, so remove its marker too
+		var err error
+		marker, err = findOwnershipMarker(r.client, state.Address.ValueString(), state.ID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to Look Up Ownership Marker",
+				"Any ownership marker of the DNS record will not be removed: "+err.Error(),
+			)
+		}
+	}
+
 	// Delete existing dns record
 	err := r.client.DeleteDNSRecord(state.Address.ValueString(), state.ID.ValueInt64())
 	if err != nil {
@@ -278,6 +376,18 @@ func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 		)
 		return
 	}
+
+	// Delete the ownership marker along with the record
+	if marker != nil {
+		err = r.client.DeleteDNSRecord(state.Address.ValueString(), marker.Record.ID)
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Ownership Marker",
+				"The DNS record was deleted, but its ownership marker could not be removed: "+err.Error(),
+			)
+			return
+		}
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -286,7 +396,15 @@ func (r *dnsRecordResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = req.ProviderData.(*omglol.Client)
+	data := req.ProviderData.(*omglolResourceData)
+	r.client = data.client
+	r.ownerID = data.ownerID
+	r.addressExpiry = data.addressExpiry
+}
+
+// ModifyPlan warns when the address is about to expire.
+func (r *dnsRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkPlanAddressExpiry(ctx, r.addressExpiry, req.Plan)...)
 }
 
 func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -312,7 +430,19 @@ func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 
+	// Refuse to adopt records owned by another configuration
+	if r.ownerID != "" {
+		if _, err := checkOwnership(r.client, state.Address.ValueString(), record.ID, r.ownerID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing DNS Record",
+				"Refusing to import DNS record: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Overwrite record with refreshed state
+	state.WaitForPropagation = types.ObjectNull(dnsPropagationAttrTypes)
 	state.Type = types.StringValue(record.Type)
 	state.Name = types.StringValue(record.Name)
 	state.FQDN = types.StringValue(record.Name + ".omg.lol")
@@ -334,3 +464,35 @@ func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 }
+
+// waitForDNSRecord blocks until the record is visible on the configured resolver, if wait_for_propagation is set.
+func waitForDNSRecord(ctx context.Context, plan dnsRecordResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.WaitForPropagation.IsNull() || plan.WaitForPropagation.IsUnknown() {
+		return diags
+	}
+
+	var wait dnsPropagationModel
+	diags.Append(plan.WaitForPropagation.As(ctx, &wait, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	expected := dnsExpectation{
+		FQDN:     plan.FQDN.ValueString(),
+		Type:     plan.Type.ValueString(),
+		Data:     plan.Data.ValueString(),
+		Priority: plan.Priority.ValueInt64(),
+	}
+
+	if err := waitForDNSPropagation(ctx, wait, expected); err != nil {
+		diags.AddAttributeError(
+			path.Root("wait_for_propagation"),
+			"Error Waiting for DNS Propagation",
+			"The DNS record was saved, but did not propagate in time: "+err.Error(),
+		)
+	}
+
+	return diags
+}
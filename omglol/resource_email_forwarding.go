@@ -0,0 +1,282 @@
+package omglol
+
+import (
+	"context"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &emailForwardingResource{}
+	_ resource.ResourceWithConfigure   = &emailForwardingResource{}
+	_ resource.ResourceWithModifyPlan  = &emailForwardingResource{}
+	_ resource.ResourceWithImportState = &emailForwardingResource{}
+)
+
+// NewEmailForwardingResource is a helper function to simplify the provider implementation.
+func NewEmailForwardingResource() resource.Resource {
+	return &emailForwardingResource{}
+}
+
+// emailForwardingResource is the resource implementation.
+type emailForwardingResource struct {
+	client        *omglol.Client
+	addressExpiry *addressExpiryChecker
+}
+
+// emailForwardingResourceModel maps the resource schema data.
+type emailForwardingResourceModel struct {
+	Address      types.String   `tfsdk:"address"`
+	Destinations []types.String `tfsdk:"destinations"`
+	EmailAddress types.String   `tfsdk:"email_address"`
+	ID           types.String   `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *emailForwardingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_forwarding"
+}
+
+// Schema defines the schema for the resource.
+func (r *emailForwardingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage where email sent to an omg.lol address, e.g. `example@omg.lol`, is forwarded to.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Your omg.lol address to forward email for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destinations": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The email addresses to forward to.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(isEmail()),
+				},
+			},
+			"email_address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The email address that is forwarded, e.g. `example@omg.lol`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *emailForwardingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan emailForwardingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *emailForwardingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state emailForwardingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed forwarding from omg.lol
+	forwarding, err := getEmailForwarding(ctx, r.client, state.Address.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Email Forwarding",
+			"Could not read email forwarding: "+err.Error(),
+		)
+		return
+	}
+
+	// Forwarding that was turned off outside of Terraform no longer exists
+	destinations := forwarding.destinations()
+	if len(destinations) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Overwrite forwarding with refreshed state
+	state.Destinations = stringValues(destinations)
+	state.EmailAddress = types.StringValue(emailForwardingAddress(forwarding, state.Address.ValueString()))
+	state.ID = types.StringValue(state.Address.ValueString())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *emailForwardingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan emailForwardingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *emailForwardingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state emailForwardingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Turn off forwarding
+	err := setEmailForwarding(ctx, r.client, state.Address.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Email Forwarding",
+			"Could not turn off email forwarding, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *emailForwardingResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*omglolResourceData)
+	r.client = data.client
+	r.addressExpiry = data.addressExpiry
+}
+
+// ModifyPlan warns when the address is about to expire.
+func (r *emailForwardingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkPlanAddressExpiry(ctx, r.addressExpiry, req.Plan)...)
+}
+
+// ImportState imports the forwarding of an address, using the address as the ID.
+func (r *emailForwardingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state emailForwardingResourceModel
+
+	// Get current forwarding from omg.lol
+	forwarding, err := getEmailForwarding(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Email Forwarding",
+			"Could not read email forwarding: "+err.Error(),
+		)
+		return
+	}
+
+	destinations := forwarding.destinations()
+	if len(destinations) == 0 {
+		resp.Diagnostics.AddError(
+			"Email Forwarding Not Found",
+			"Email forwarding is not set up for "+req.ID+", so there is nothing to import.",
+		)
+		return
+	}
+
+	state.Address = types.StringValue(req.ID)
+	state.Destinations = stringValues(destinations)
+	state.EmailAddress = types.StringValue(emailForwardingAddress(forwarding, req.ID))
+	state.ID = types.StringValue(req.ID)
+
+	// Set refreshed state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// apply sets the planned destinations, then reads back the forwarded email address.
+func (r *emailForwardingResource) apply(ctx context.Context, plan *emailForwardingResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	destinations := make([]string, len(plan.Destinations))
+	for i, d := range plan.Destinations {
+		destinations[i] = d.ValueString()
+	}
+
+	err := setEmailForwarding(ctx, r.client, plan.Address.ValueString(), destinations)
+	if err != nil {
+		diags.AddError(
+			"Error Setting Email Forwarding",
+			"Could not set email forwarding, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	forwarding, err := getEmailForwarding(ctx, r.client, plan.Address.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Reading Email Forwarding",
+			"Could not read email forwarding: "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.EmailAddress = types.StringValue(emailForwardingAddress(forwarding, plan.Address.ValueString()))
+	plan.ID = types.StringValue(plan.Address.ValueString())
+
+	return diags
+}
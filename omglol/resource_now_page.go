@@ -0,0 +1,238 @@
+package omglol
+
+import (
+	"context"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &nowPageResource{}
+	_ resource.ResourceWithConfigure   = &nowPageResource{}
+	_ resource.ResourceWithModifyPlan  = &nowPageResource{}
+	_ resource.ResourceWithImportState = &nowPageResource{}
+)
+
+// NewNowPageResource is a helper function to simplify the provider implementation.
+func NewNowPageResource() resource.Resource {
+	return &nowPageResource{}
+}
+
+// nowPageResource is the resource implementation.
+type nowPageResource struct {
+	client        *omglol.Client
+	addressExpiry *addressExpiryChecker
+}
+
+// nowPageResourceModel maps the resource schema data.
+type nowPageResourceModel struct {
+	Address types.String `tfsdk:"address"`
+	Content types.String `tfsdk:"content"`
+	Listed  types.Bool   `tfsdk:"listed"`
+	Updated types.String `tfsdk:"updated"`
+	ID      types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *nowPageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_now_page"
+}
+
+// Schema defines the schema for the resource.
+func (r *nowPageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the /now page of an omg.lol address.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Your omg.lol address to manage the /now page of.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The Markdown source of the /now page.",
+			},
+			"listed": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Set true to list the page in the [Now Garden](https://now.garden).",
+			},
+			"updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC 3339 representation of the time the page was last updated.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *nowPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan nowPageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save /now page
+	err := setNowPage(ctx, r.client, plan.Address.ValueString(), plan.Content.ValueString(), plan.Listed.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Saving Now Page",
+			"Could not save now page, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	r.refreshUpdated(ctx, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *nowPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state nowPageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed /now page from omg.lol
+	page, err := getNowPage(ctx, r.client, state.Address.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Now Page",
+			"Could not read now page: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite /now page with refreshed state
+	state.Content = types.StringValue(page.Content)
+	state.Listed = types.BoolValue(page.listed())
+	state.Updated = unixTimeValue(int64(page.Updated))
+	state.ID = types.StringValue(state.Address.ValueString())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *nowPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan nowPageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save /now page
+	err := setNowPage(ctx, r.client, plan.Address.ValueString(), plan.Content.ValueString(), plan.Listed.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Saving Now Page",
+			"Could not save now page, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	r.refreshUpdated(ctx, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state. A /now page cannot be removed, so it is left as it is.
+func (r *nowPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *nowPageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*omglolResourceData)
+	r.client = data.client
+	r.addressExpiry = data.addressExpiry
+}
+
+// ModifyPlan warns when the address is about to expire.
+func (r *nowPageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkPlanAddressExpiry(ctx, r.addressExpiry, req.Plan)...)
+}
+
+// ImportState imports the /now page of an address, using the address as the ID.
+func (r *nowPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state nowPageResourceModel
+
+	// Get current /now page from omg.lol
+	page, err := getNowPage(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Now Page",
+			"Could not read now page: "+err.Error(),
+		)
+		return
+	}
+
+	state.Address = types.StringValue(req.ID)
+	state.Content = types.StringValue(page.Content)
+	state.Listed = types.BoolValue(page.listed())
+	state.Updated = unixTimeValue(int64(page.Updated))
+	state.ID = types.StringValue(req.ID)
+
+	// Set refreshed state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// refreshUpdated reads back the time the page was updated after saving it. The page has already been saved, so a
+// failure falls back to the current time rather than failing the apply.
+func (r *nowPageResource) refreshUpdated(ctx context.Context, plan *nowPageResourceModel) {
+	plan.ID = types.StringValue(plan.Address.ValueString())
+
+	page, err := getNowPage(ctx, r.client, plan.Address.ValueString())
+	if err != nil || page.Updated == 0 {
+		plan.Updated = lastUpdatedNow()
+		return
+	}
+	plan.Updated = unixTimeValue(int64(page.Updated))
+}
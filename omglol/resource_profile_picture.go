@@ -0,0 +1,281 @@
+package omglol
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &profilePictureResource{}
+	_ resource.ResourceWithConfigure  = &profilePictureResource{}
+	_ resource.ResourceWithModifyPlan = &profilePictureResource{}
+)
+
+// profilePictureMaxSize is the largest image accepted for upload, in bytes.
+const profilePictureMaxSize = 5 * 1024 * 1024
+
+// profilePictureTypes are the image types accepted for upload, as detected from the image data.
+var profilePictureTypes = []string{"image/gif", "image/jpeg", "image/png", "image/webp"}
+
+// NewProfilePictureResource is a helper function to simplify the provider implementation.
+func NewProfilePictureResource() resource.Resource {
+	return &profilePictureResource{}
+}
+
+// profilePictureResource is the resource implementation.
+type profilePictureResource struct {
+	client        *omglol.Client
+	addressExpiry *addressExpiryChecker
+}
+
+// profilePictureResourceModel maps the resource schema data.
+type profilePictureResourceModel struct {
+	Address       types.String `tfsdk:"address"`
+	SourceFile    types.String `tfsdk:"source_file"`
+	ContentBase64 types.String `tfsdk:"content_base64"`
+	ContentType   types.String `tfsdk:"content_type"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	LastUpdated   types.String `tfsdk:"last_updated"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *profilePictureResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_profile_picture"
+}
+
+// Schema defines the schema for the resource.
+func (r *profilePictureResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Upload the profile picture of an omg.lol address. The image must be a GIF, JPEG, PNG or WebP of up to %d MiB.", profilePictureMaxSize/1024/1024),
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Your omg.lol address to upload the profile picture for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path of a local image file. The file is read during plan, and the picture is uploaded again whenever its contents change. Exactly one of `source_file` and `content_base64` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content_base64")),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The image, encoded as base64, e.g. with the `filebase64` function.",
+			},
+			"content_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of the image, detected from its contents.",
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hex encoded SHA-256 hash of the image.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC 3339 representation of the time the picture was last uploaded by Terraform.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *profilePictureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan profilePictureResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.upload(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information. The API does not return the uploaded picture, so there is nothing to refresh.
+func (r *profilePictureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *profilePictureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan profilePictureResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.upload(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state. The API cannot remove a profile picture, so it is left as it is.
+func (r *profilePictureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *profilePictureResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*omglolResourceData)
+	r.client = data.client
+	r.addressExpiry = data.addressExpiry
+}
+
+// ModifyPlan warns when the address is about to expire, and validates the image and plans its hash, so that the
+// picture is uploaded again when the image changes.
+func (r *profilePictureResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkPlanAddressExpiry(ctx, r.addressExpiry, req.Plan)...)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan profilePictureResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values are not yet known, the image will be validated during apply
+	if plan.SourceFile.IsUnknown() || plan.ContentBase64.IsUnknown() {
+		return
+	}
+
+	image, contentType, diags := readProfilePicture(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ContentType = types.StringValue(contentType)
+	plan.ContentSHA256 = types.StringValue(contentSHA256(string(image)))
+
+	// The image may have changed without any change to the configuration
+	if !req.State.Raw.IsNull() {
+		var state profilePictureResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !state.ContentSHA256.Equal(plan.ContentSHA256) {
+			plan.LastUpdated = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// upload validates and uploads the planned image.
+func (r *profilePictureResource) upload(ctx context.Context, plan *profilePictureResourceModel) diag.Diagnostics {
+	image, contentType, diags := readProfilePicture(*plan)
+	if diags.HasError() {
+		return diags
+	}
+
+	err := doRawAPIRequest(ctx, r.client, http.MethodPost, "/address/"+url.PathEscape(plan.Address.ValueString())+"/pfp", contentType, image, nil)
+	if err != nil {
+		diags.AddError(
+			"Error Uploading Profile Picture",
+			"Could not upload profile picture, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.ContentType = types.StringValue(contentType)
+	plan.ContentSHA256 = types.StringValue(contentSHA256(string(image)))
+	plan.LastUpdated = lastUpdatedNow()
+	plan.ID = types.StringValue(plan.Address.ValueString())
+
+	return diags
+}
+
+// readProfilePicture returns the image from source_file or content_base64, after checking its type and size.
+func readProfilePicture(plan profilePictureResourceModel) ([]byte, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var image []byte
+	var err error
+	attribute := path.Root("content_base64")
+	if !plan.SourceFile.IsNull() {
+		attribute = path.Root("source_file")
+		image, err = os.ReadFile(plan.SourceFile.ValueString())
+	} else {
+		image, err = base64.StdEncoding.DecodeString(plan.ContentBase64.ValueString())
+	}
+	if err != nil {
+		diags.AddAttributeError(attribute, "Unable to Read Profile Picture", err.Error())
+		return nil, "", diags
+	}
+
+	if len(image) > profilePictureMaxSize {
+		diags.AddAttributeError(
+			attribute,
+			"Profile Picture Too Large",
+			fmt.Sprintf("The image is %d bytes, but may be at most %d bytes.", len(image), profilePictureMaxSize),
+		)
+		return nil, "", diags
+	}
+
+	contentType := http.DetectContentType(image)
+	for _, t := range profilePictureTypes {
+		if contentType == t {
+			return image, contentType, diags
+		}
+	}
+
+	diags.AddAttributeError(
+		attribute,
+		"Unsupported Profile Picture Type",
+		fmt.Sprintf("The image must be a GIF, JPEG, PNG or WebP, got: %s", contentType),
+	)
+	return nil, "", diags
+}
@@ -2,14 +2,18 @@ package omglol
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"strings"
-	"time"
 
 	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -17,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &pURLResource{}
 	_ resource.ResourceWithConfigure   = &pURLResource{}
+	_ resource.ResourceWithModifyPlan  = &pURLResource{}
 	_ resource.ResourceWithImportState = &pURLResource{}
 )
 
@@ -27,18 +32,21 @@ func NewPURLResource() resource.Resource {
 
 // purlResource is the resource implementation.
 type pURLResource struct {
-	client *omglol.Client
+	client        *omglol.Client
+	addressExpiry *addressExpiryChecker
 }
 
 // pURLResourceModel maps the resource schema data.
 type pURLResourceModel struct {
-	Name      types.String `tfsdk:"name"`
-	Address   types.String `tfsdk:"address"`
-	URL       types.String `tfsdk:"url"`
-	Listed    types.Bool   `tfsdk:"listed"`
-	Counter   types.Int64  `tfsdk:"counter"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
-	ID        types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Address        types.String `tfsdk:"address"`
+	URL            types.String `tfsdk:"url"`
+	Listed         types.Bool   `tfsdk:"listed"`
+	AllowAnyScheme types.Bool   `tfsdk:"allow_any_scheme"`
+	AdoptExisting  types.Bool   `tfsdk:"adopt_existing"`
+	Counter        types.Int64  `tfsdk:"counter"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ID             types.String `tfsdk:"id"`
 }
 
 // Metadata returns the resource type name.
@@ -60,14 +68,29 @@ func (r *pURLResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"name": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The name of the PURL. The name field is how you will access your designated URL.",
+				MarkdownDescription: "The name of the PURL. The name field is how you will access your designated URL. May contain letters, numbers, `-` and `_`, up to " + strconv.Itoa(pURLNameMaxLength) + " characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, pURLNameMaxLength),
+					stringvalidator.RegexMatches(pURLNameRegexp, "must only contain letters, numbers, `-` and `_`"),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"url": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The URL to link to.",
+				MarkdownDescription: "The URL to link to. Must be an absolute `http` or `https` URL, unless `allow_any_scheme` is set.",
+				Validators: []validator.String{
+					isAbsoluteURL("allow_any_scheme"),
+				},
+			},
+			"allow_any_scheme": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to allow a `url` with a scheme other than `http` or `https`, e.g. `mailto:` or `ftp://`.",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to take over a PURL with the same `name` that already exists on the `address`, overwriting its `url` and `listed` values. Defaults to `false`, in which case creating the resource fails if the PURL already exists, and it should be imported instead.",
 			},
 			"listed": schema.BoolAttribute{
 				Required:            true,
@@ -75,10 +98,14 @@ func (r *pURLResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"counter": schema.Int64Attribute{
 				Computed:            true,
-				MarkdownDescription: "The number of time a PURL has been accessed.",
+				MarkdownDescription: "**Deprecated**: use the `omglol_purl_stats` data source to read current hit counts. The counter changes with every visit, so it is no longer tracked by this resource and is always null.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
-				Computed: true,
+				Computed:            true,
+				MarkdownDescription: "The RFC 3339 representation of the time the PURL was last created or updated by Terraform. The API does not report modification times for PURLs, so changes made outside of Terraform are not reflected.",
 			},
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -100,6 +127,26 @@ func (r *pURLResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// CreatePersistentURL overwrites any PURL with the same name, so check whether one exists first
+	if !plan.AdoptExisting.ValueBool() {
+		_, err := r.client.GetPersistentURL(plan.Address.ValueString(), plan.Name.ValueString())
+		if err == nil {
+			resp.Diagnostics.AddError(
+				"Persistent URL Already Exists",
+				fmt.Sprintf("A persistent URL named %q already exists on %s. Import it with `terraform import` using the ID %q, or set adopt_existing to true to take it over.",
+					plan.Name.ValueString(), plan.Address.ValueString(), pURLID(plan.Address.ValueString(), plan.Name.ValueString())),
+			)
+			return
+		}
+		if !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error Creating Persistent URL",
+				"Could not check for an existing persistent URL, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Generate API request body from plan
 	purl := omglol.NewPersistentURL(plan.Name.ValueString(), plan.URL.ValueString(), plan.Listed.ValueBool())
 
@@ -113,9 +160,9 @@ func (r *pURLResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	plan.Counter = types.Int64Value(0)
-	plan.UpdatedAt = types.StringValue(time.Now().Format(time.RFC850))
-	plan.ID = types.StringValue(plan.Address.ValueString() + "_" + plan.Name.ValueString())
+	plan.Counter = types.Int64Null()
+	plan.UpdatedAt = lastUpdatedNow()
+	plan.ID = types.StringValue(pURLID(plan.Address.ValueString(), plan.Name.ValueString()))
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -150,11 +197,12 @@ func (r *pURLResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Overwrite purl with refreshed state
-	state.ID = types.StringValue(state.Address.ValueString() + "_" + state.Name.ValueString())
+	state.ID = types.StringValue(pURLID(state.Address.ValueString(), state.Name.ValueString()))
 	state.Name = types.StringValue(purl.Name)
 	state.URL = types.StringValue(purl.URL)
-	state.Counter = types.Int64Value(*purl.Counter)
 	state.Listed = types.BoolValue(purl.Listed)
+	// Clear counters recorded by earlier imports, the counter is no longer tracked
+	state.Counter = types.Int64Null()
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -198,9 +246,8 @@ func (r *pURLResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	plan.Listed = types.BoolValue(purl.Listed)
-	plan.Counter = types.Int64Value(*purl.Counter)
-	plan.UpdatedAt = types.StringValue(time.Now().Format(time.RFC850))
-	plan.ID = types.StringValue(plan.Address.ValueString() + "_" + plan.Name.ValueString())
+	plan.UpdatedAt = lastUpdatedNow()
+	plan.ID = types.StringValue(pURLID(plan.Address.ValueString(), plan.Name.ValueString()))
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -237,19 +284,31 @@ func (r *pURLResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	r.client = req.ProviderData.(*omglol.Client)
+	data := req.ProviderData.(*omglolResourceData)
+	r.client = data.client
+	r.addressExpiry = data.addressExpiry
+}
+
+// ModifyPlan warns when the address is about to expire.
+func (r *pURLResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkPlanAddressExpiry(ctx, r.addressExpiry, req.Plan)...)
 }
 
 func (r *pURLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var state pURLResourceModel
 
-	// Retrieve import ID and save to id attribute
-	state.ID = types.StringValue(req.ID)
-
 	// Split ID into name and address
-	parts := strings.Split(req.ID, "_")
-	state.Address = types.StringValue(parts[0])
-	state.Name = types.StringValue(parts[1])
+	address, name, err := parsePURLID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			err.Error(),
+		)
+		return
+	}
+	state.Address = types.StringValue(address)
+	state.Name = types.StringValue(name)
+	state.ID = types.StringValue(pURLID(address, name))
 
 	// Get refreshed pURL from omg.lol
 	purl, err := r.client.GetPersistentURL(state.Address.ValueString(), state.Name.ValueString())
@@ -265,7 +324,7 @@ func (r *pURLResource) ImportState(ctx context.Context, req resource.ImportState
 
 	state.Name = types.StringValue(purl.Name)
 	state.URL = types.StringValue(purl.URL)
-	state.Counter = types.Int64Value(*purl.Counter)
+	state.Counter = types.Int64Null()
 	state.Listed = types.BoolValue(purl.Listed)
 
 	// Set refreshed state
@@ -275,3 +334,38 @@ func (r *pURLResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 }
+
+// pURLID returns the ID of a PURL, in the form `address/name`.
+func pURLID(address string, name string) string {
+	return address + "/" + name
+}
+
+// pURLPublicURL returns the public short link of a PURL.
+func pURLPublicURL(address string, name string) string {
+	return "https://" + address + ".url.lol/" + name
+}
+
+// parsePURLID splits a PURL ID into its address and name. Both the `address/name` form and the legacy
+// `address_name` form are accepted.
+func parsePURLID(id string) (string, string, error) {
+	if parts := strings.Split(id, "/"); len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	if !strings.Contains(id, "/") {
+		// Addresses cannot contain underscores, so the first one always ends the address
+		if parts := strings.SplitN(id, "_", 2); len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+			return parts[0], parts[1], nil
+		}
+	}
+
+	return "", "", fmt.Errorf("expected an import ID in the form `address/name`, e.g. `example/my_link`, got: %q", id)
+}
+
+// pURLCounter returns the hit counter of a PURL, or null if the API did not return one.
+func pURLCounter(purl *omglol.PersistentURL) types.Int64 {
+	if purl.Counter == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*purl.Counter)
+}
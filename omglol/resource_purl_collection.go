@@ -0,0 +1,471 @@
+package omglol
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &pURLCollectionResource{}
+	_ resource.ResourceWithConfigure   = &pURLCollectionResource{}
+	_ resource.ResourceWithModifyPlan  = &pURLCollectionResource{}
+	_ resource.ResourceWithImportState = &pURLCollectionResource{}
+)
+
+// NewPURLCollectionResource is a helper function to simplify the provider implementation.
+func NewPURLCollectionResource() resource.Resource {
+	return &pURLCollectionResource{}
+}
+
+// pURLCollectionResource is the resource implementation.
+type pURLCollectionResource struct {
+	client        *omglol.Client
+	addressExpiry *addressExpiryChecker
+}
+
+// pURLCollectionResourceModel maps the resource schema data.
+type pURLCollectionResourceModel struct {
+	Address         types.String `tfsdk:"address"`
+	PURLs           types.Map    `tfsdk:"purls"`
+	AllowAnyScheme  types.Bool   `tfsdk:"allow_any_scheme"`
+	RemoveUnmanaged types.Bool   `tfsdk:"remove_unmanaged"`
+	Unmanaged       types.List   `tfsdk:"unmanaged"`
+	ID              types.String `tfsdk:"id"`
+}
+
+type pURLCollectionEntryModel struct {
+	URL    types.String `tfsdk:"url"`
+	Listed types.Bool   `tfsdk:"listed"`
+}
+
+var pURLCollectionEntryAttrTypes = map[string]attr.Type{
+	"url":    types.StringType,
+	"listed": types.BoolType,
+}
+
+// Metadata returns the resource type name.
+func (r *pURLCollectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_purl_collection"
+}
+
+// Schema defines the schema for the resource.
+func (r *pURLCollectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage all omg.lol Persistent URLs of an address as a single resource.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Your omg.lol address to manage the PURLs for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"purls": schema.MapNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The PURLs to manage, keyed by name. Names may contain letters, numbers, `-` and `_`, up to " + strconv.Itoa(pURLNameMaxLength) + " characters.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.LengthBetween(1, pURLNameMaxLength),
+						stringvalidator.RegexMatches(pURLNameRegexp, "must only contain letters, numbers, `-` and `_`"),
+					),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The URL to link to. Must be an absolute `http` or `https` URL, unless `allow_any_scheme` is set.",
+							Validators: []validator.String{
+								isAbsoluteURL("allow_any_scheme"),
+							},
+						},
+						"listed": schema.BoolAttribute{
+							Optional:            true,
+							MarkdownDescription: "Set true to list on your `address`.url.lol page. Defaults to `false`.",
+						},
+					},
+				},
+			},
+			"allow_any_scheme": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to allow URLs with a scheme other than `http` or `https`, e.g. `mailto:` or `ftp://`.",
+			},
+			"remove_unmanaged": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set true to delete every PURL of the address that is not listed in `purls`. Defaults to `false`.",
+			},
+			"unmanaged": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of PURLs on the address that are not listed in `purls`. Always empty after an apply when `remove_unmanaged` is set.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *pURLCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan pURLCollectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Address.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *pURLCollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state pURLCollectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := make(map[string]pURLCollectionEntryModel)
+	resp.Diagnostics.Append(state.PURLs.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed PURLs from omg.lol
+	existing, err := r.client.ListPersistentURLs(state.Address.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Persistent URLs",
+			"Could not read persistent URLs: "+err.Error(),
+		)
+		return
+	}
+
+	refreshed := make(map[string]pURLCollectionEntryModel)
+	var unmanaged []string
+	for _, purl := range *existing {
+		entry, ok := managed[purl.Name]
+		if !ok {
+			unmanaged = append(unmanaged, purl.Name)
+			continue
+		}
+
+		// An unset listed attribute means false, keep it unset to avoid a diff
+		listed := types.BoolValue(purl.Listed)
+		if entry.Listed.IsNull() && !purl.Listed {
+			listed = types.BoolNull()
+		}
+
+		refreshed[purl.Name] = pURLCollectionEntryModel{
+			URL:    types.StringValue(purl.URL),
+			Listed: listed,
+		}
+	}
+
+	// PURLs deleted outside of Terraform are dropped, so they are recreated on the next apply
+	state.PURLs, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: pURLCollectionEntryAttrTypes}, refreshed)
+	resp.Diagnostics.Append(diags...)
+	state.Unmanaged, diags = pURLNameList(unmanaged)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *pURLCollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state pURLCollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous := make(map[string]pURLCollectionEntryModel)
+	resp.Diagnostics.Append(state.PURLs.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, previous)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Address.ValueString())
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *pURLCollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state pURLCollectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := make(map[string]pURLCollectionEntryModel)
+	resp.Diagnostics.Append(state.PURLs.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the managed PURLs, unmanaged PURLs are left alone
+	for _, name := range sortedPURLNames(managed) {
+		err := r.client.DeletePersistentURL(state.Address.ValueString(), name)
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting PURL",
+				fmt.Sprintf("Could not delete persistent URL %s, unexpected error: %s", name, err.Error()),
+			)
+		}
+	}
+}
+
+// ModifyPlan warns when the address is about to expire, and plans the removal of unmanaged PURLs when
+// remove_unmanaged is set.
+func (r *pURLCollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkPlanAddressExpiry(ctx, r.addressExpiry, req.Plan)...)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan pURLCollectionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RemoveUnmanaged.ValueBool() {
+		plan.Unmanaged, _ = pURLNameList(nil)
+	} else if !req.State.Raw.IsNull() {
+		var state pURLCollectionResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// PURLs dropped from the configuration become unmanaged, so only keep the list when names are unchanged
+		if plan.PURLs.IsUnknown() || !sameMapKeys(plan.PURLs, state.PURLs) {
+			plan.Unmanaged = types.ListUnknown(types.StringType)
+		} else {
+			plan.Unmanaged = state.Unmanaged
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *pURLCollectionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*omglolResourceData)
+	r.client = data.client
+	r.addressExpiry = data.addressExpiry
+}
+
+func (r *pURLCollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state pURLCollectionResourceModel
+
+	// Import every existing PURL of the address, the ID is the address
+	existing, err := r.client.ListPersistentURLs(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Persistent URLs",
+			"Could not read persistent URLs: "+err.Error(),
+		)
+		return
+	}
+
+	managed := make(map[string]pURLCollectionEntryModel)
+	for _, purl := range *existing {
+		// Unlisted PURLs are imported with listed unset, matching its default
+		listed := types.BoolNull()
+		if purl.Listed {
+			listed = types.BoolValue(true)
+		}
+
+		managed[purl.Name] = pURLCollectionEntryModel{
+			URL:    types.StringValue(purl.URL),
+			Listed: listed,
+		}
+	}
+
+	var diags diag.Diagnostics
+	state.Address = types.StringValue(req.ID)
+	state.ID = types.StringValue(req.ID)
+	state.PURLs, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: pURLCollectionEntryAttrTypes}, managed)
+	resp.Diagnostics.Append(diags...)
+	state.Unmanaged, diags = pURLNameList(nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// apply reconciles the PURLs of the address with the plan. previous holds the PURLs managed before this apply,
+// so PURLs removed from the configuration can be deleted. An unknown unmanaged attribute is populated.
+func (r *pURLCollectionResource) apply(ctx context.Context, plan *pURLCollectionResourceModel, previous map[string]pURLCollectionEntryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	address := plan.Address.ValueString()
+
+	desired := make(map[string]pURLCollectionEntryModel)
+	diags.Append(plan.PURLs.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	existing, err := r.client.ListPersistentURLs(address)
+	if err != nil {
+		diags.AddError(
+			"Error reading Persistent URLs",
+			"Could not read persistent URLs: "+err.Error(),
+		)
+		return diags
+	}
+
+	current := make(map[string]omglol.PersistentURL, len(*existing))
+	for _, purl := range *existing {
+		current[purl.Name] = purl
+	}
+
+	// Create or update PURLs which differ from the configuration
+	for _, name := range sortedPURLNames(desired) {
+		entry := desired[name]
+		if purl, ok := current[name]; ok && purl.URL == entry.URL.ValueString() && purl.Listed == entry.Listed.ValueBool() {
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Saving PURL %s on address: %s", name, address))
+		purl := omglol.NewPersistentURL(name, entry.URL.ValueString(), entry.Listed.ValueBool())
+		if err := r.client.CreatePersistentURL(address, *purl); err != nil {
+			diags.AddError(
+				"Error Saving Persistent URL",
+				fmt.Sprintf("Could not save persistent URL %s, unexpected error: %s", name, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	// Delete PURLs which are no longer configured, and any unmanaged ones if requested
+	var unmanaged []string
+	for _, purl := range *existing {
+		if _, ok := desired[purl.Name]; ok {
+			continue
+		}
+
+		_, wasManaged := previous[purl.Name]
+		if !wasManaged && !plan.RemoveUnmanaged.ValueBool() {
+			unmanaged = append(unmanaged, purl.Name)
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Deleting PURL %s on address: %s", purl.Name, address))
+		if err := r.client.DeletePersistentURL(address, purl.Name); err != nil && !isNotFoundError(err) {
+			diags.AddError(
+				"Error Deleting PURL",
+				fmt.Sprintf("Could not delete persistent URL %s, unexpected error: %s", purl.Name, err.Error()),
+			)
+			return diags
+		}
+	}
+
+	// Keep a planned value, PURLs created by hand since the plan are picked up on the next refresh
+	if plan.Unmanaged.IsUnknown() {
+		var d diag.Diagnostics
+		plan.Unmanaged, d = pURLNameList(unmanaged)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// pURLNameList converts PURL names into a sorted, non-null list value.
+func pURLNameList(names []string) (types.List, diag.Diagnostics) {
+	sorted := append([]string{}, names...)
+	sort.Strings(sorted)
+	return types.ListValueFrom(context.Background(), types.StringType, sorted)
+}
+
+func sortedPURLNames(purls map[string]pURLCollectionEntryModel) []string {
+	names := make([]string, 0, len(purls))
+	for name := range purls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sameMapKeys(a types.Map, b types.Map) bool {
+	ae, be := a.Elements(), b.Elements()
+	if len(ae) != len(be) {
+		return false
+	}
+	for k := range ae {
+		if _, ok := be[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,351 @@
+package omglol
+
+import (
+	"context"
+	"os"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &webPageResource{}
+	_ resource.ResourceWithConfigure   = &webPageResource{}
+	_ resource.ResourceWithModifyPlan  = &webPageResource{}
+	_ resource.ResourceWithImportState = &webPageResource{}
+)
+
+// NewWebPageResource is a helper function to simplify the provider implementation.
+func NewWebPageResource() resource.Resource {
+	return &webPageResource{}
+}
+
+// webPageResource is the resource implementation.
+type webPageResource struct {
+	client        *omglol.Client
+	addressExpiry *addressExpiryChecker
+}
+
+// webPageResourceModel maps the resource schema data.
+type webPageResourceModel struct {
+	Address       types.String `tfsdk:"address"`
+	Content       types.String `tfsdk:"content"`
+	SourceFile    types.String `tfsdk:"source_file"`
+	TemplateVars  types.Map    `tfsdk:"template_vars"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	Publish       types.Bool   `tfsdk:"publish"`
+	LastUpdated   types.String `tfsdk:"last_updated"`
+	ID            types.String `tfsdk:"id"`
+}
+
+// Metadata returns the resource type name.
+func (r *webPageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_web_page"
+}
+
+// Schema defines the schema for the resource.
+func (r *webPageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the profile web page of an omg.lol address.",
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Your omg.lol address to manage the web page of.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The Markdown source of the web page, including any front matter. Exactly one of `content` and `source_file` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("source_file")),
+				},
+			},
+			"source_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The path of a local file holding the Markdown source of the web page. The file is read during plan, and only `content_sha256` is stored in the state, so plans show a hash change instead of the full document.",
+			},
+			"template_vars": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Variables to substitute into the source. When set, each `${name}` in the source is replaced with the value of `name`, and referencing an undefined variable is an error. Use `$${` for a literal `${`.",
+			},
+			"content_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The hex encoded SHA-256 hash of the rendered source. Changes made outside of Terraform show up as a change of this hash.",
+			},
+			"publish": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set false to save the content without publishing it. Defaults to `true`. The API does not report whether the saved content has been published, so this is not refreshed.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The RFC 3339 representation of the time the page was last saved by Terraform.",
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *webPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan webPageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, diags := renderWebPageSource(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save web page
+	err := setWebPage(ctx, r.client, plan.Address.ValueString(), content, webPagePublish(plan.Publish))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Saving Web Page",
+			"Could not save web page, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ContentSHA256 = types.StringValue(contentSHA256(content))
+	plan.LastUpdated = lastUpdatedNow()
+	plan.ID = types.StringValue(plan.Address.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *webPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state webPageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed web page from omg.lol
+	page, err := getWebPage(ctx, r.client, state.Address.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Web Page",
+			"Could not read web page: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite web page with refreshed state. Rendered pages only keep track of the hash.
+	if !state.Content.IsNull() && state.TemplateVars.IsNull() {
+		state.Content = types.StringValue(page.Content)
+	}
+	state.ContentSHA256 = types.StringValue(contentSHA256(page.Content))
+	state.ID = types.StringValue(state.Address.ValueString())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *webPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan webPageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, diags := renderWebPageSource(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save web page
+	err := setWebPage(ctx, r.client, plan.Address.ValueString(), content, webPagePublish(plan.Publish))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Saving Web Page",
+			"Could not save web page, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ContentSHA256 = types.StringValue(contentSHA256(content))
+	plan.LastUpdated = lastUpdatedNow()
+	plan.ID = types.StringValue(plan.Address.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state. Every address has a web page, so it is left as it is.
+func (r *webPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webPageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*omglolResourceData)
+	r.client = data.client
+	r.addressExpiry = data.addressExpiry
+}
+
+// ModifyPlan warns when the address is about to expire, and plans the hash of the rendered source so that changes
+// to a source file, or to the page outside of Terraform, show up as a hash change.
+func (r *webPageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkPlanAddressExpiry(ctx, r.addressExpiry, req.Plan)...)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan webPageResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values are not yet known, the hash will be computed during apply
+	if plan.Content.IsUnknown() || plan.SourceFile.IsUnknown() || plan.TemplateVars.IsUnknown() {
+		return
+	}
+
+	content, diags := renderWebPageSource(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ContentSHA256 = types.StringValue(contentSHA256(content))
+
+	// A changed hash may be the only difference to the state, in which case the page is saved again
+	if !req.State.Raw.IsNull() {
+		var state webPageResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !state.ContentSHA256.Equal(plan.ContentSHA256) {
+			plan.LastUpdated = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// ImportState imports the web page of an address, using the address as the ID.
+func (r *webPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state webPageResourceModel
+
+	// Get current web page from omg.lol
+	page, err := getWebPage(ctx, r.client, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Web Page",
+			"Could not read web page: "+err.Error(),
+		)
+		return
+	}
+
+	state.Address = types.StringValue(req.ID)
+	state.Content = types.StringValue(page.Content)
+	state.ContentSHA256 = types.StringValue(contentSHA256(page.Content))
+	state.Publish = types.BoolNull()
+	state.LastUpdated = types.StringNull()
+	state.ID = types.StringValue(req.ID)
+
+	// Set refreshed state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// webPagePublish returns whether the page should be published, which it is unless publish is set to false.
+func webPagePublish(publish types.Bool) bool {
+	return publish.IsNull() || publish.ValueBool()
+}
+
+// renderWebPageSource returns the page source from content or source_file, with template_vars substituted if set.
+func renderWebPageSource(ctx context.Context, plan webPageResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	source := plan.Content.ValueString()
+	if !plan.SourceFile.IsNull() {
+		b, err := os.ReadFile(plan.SourceFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("source_file"),
+				"Unable to Read Source File",
+				err.Error(),
+			)
+			return "", diags
+		}
+		source = string(b)
+	}
+
+	if plan.TemplateVars.IsNull() {
+		return source, diags
+	}
+
+	vars := map[string]string{}
+	diags.Append(plan.TemplateVars.ElementsAs(ctx, &vars, false)...)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	rendered, err := renderTemplate(source, vars)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("template_vars"),
+			"Unable to Render Web Page",
+			err.Error(),
+		)
+		return "", diags
+	}
+
+	return rendered, diags
+}
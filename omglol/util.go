@@ -2,10 +2,44 @@ package omglol
 
 import (
 	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var errorNotFoundRegexp = regexp.MustCompile("status: 404|NOT_FOUND|does not exist|No records match the filter criteria")
 
 func isNotFoundError(err error) bool {
 	return errorNotFoundRegexp.MatchString(err.Error())
-}
\ No newline at end of file
+}
+
+// lastUpdatedNow returns the current UTC time in RFC 3339 format. Used for objects where the API does not
+// report a modification time, so the value records when Terraform last changed the object.
+func lastUpdatedNow() types.String {
+	return types.StringValue(time.Now().UTC().Format(time.RFC3339))
+}
+
+// stringPointerValue converts an optional string returned by the API, which is null when absent.
+func stringPointerValue(value *string) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
+
+// unixTimeValue converts a Unix epoch time returned by the API into RFC 3339 format. A zero time is treated as absent.
+func unixTimeValue(seconds int64) types.String {
+	if seconds == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Unix(seconds, 0).UTC().Format(time.RFC3339))
+}
+
+// stringValues converts a slice of strings returned by the API into Terraform values.
+func stringValues(values []string) []types.String {
+	result := make([]types.String, len(values))
+	for i, v := range values {
+		result[i] = types.StringValue(v)
+	}
+	return result
+}
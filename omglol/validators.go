@@ -0,0 +1,185 @@
+package omglol
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ validator.String = durationValidator{}
+	_ validator.String = absoluteURLValidator{}
+	_ validator.String = regexValidator{}
+	_ validator.String = emailValidator{}
+)
+
+// pURLNameRegexp matches the names omg.lol accepts for PURLs.
+var pURLNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+const pURLNameMaxLength = 64
+
+// durationValidator checks that a string can be parsed by time.ParseDuration and is positive.
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration such as `30s` or `5m`"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// isDuration returns a validator which ensures the value is a positive duration.
+func isDuration() validator.String {
+	return durationValidator{}
+}
+
+// absoluteURLValidator checks that a string is an absolute URL. Only http and https URLs are accepted, unless the
+// boolean attribute named by allowAnySchemeAttribute is set to true.
+type absoluteURLValidator struct {
+	allowAnySchemeAttribute string
+}
+
+func (v absoluteURLValidator) Description(_ context.Context) string {
+	if v.allowAnySchemeAttribute != "" {
+		return fmt.Sprintf("value must be an absolute http or https URL, or an absolute URL with any scheme when `%s` is true", v.allowAnySchemeAttribute)
+	}
+	return "value must be an absolute http or https URL"
+}
+
+func (v absoluteURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v absoluteURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	u, err := url.Parse(value)
+	if err != nil || !u.IsAbs() || (u.Host == "" && u.Opaque == "") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+		)
+		return
+	}
+
+	if u.Scheme == "http" || u.Scheme == "https" {
+		if u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid URL",
+				fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+			)
+		}
+		return
+	}
+
+	if v.allowAnySchemeAttribute != "" {
+		var allowAnyScheme types.Bool
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(v.allowAnySchemeAttribute), &allowAnyScheme)...)
+		if allowAnyScheme.IsUnknown() || allowAnyScheme.ValueBool() {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid URL Scheme",
+		fmt.Sprintf("Attribute %s %s, got scheme %q in: %s", req.Path, v.Description(ctx), u.Scheme, value),
+	)
+}
+
+// isAbsoluteURL returns a validator which ensures the value is an absolute http(s) URL. Other schemes are allowed
+// when the root boolean attribute allowAnySchemeAttribute is true; pass an empty string to never allow them.
+func isAbsoluteURL(allowAnySchemeAttribute string) validator.String {
+	return absoluteURLValidator{allowAnySchemeAttribute: allowAnySchemeAttribute}
+}
+
+// regexValidator checks that a string is a valid regular expression.
+type regexValidator struct{}
+
+func (v regexValidator) Description(_ context.Context) string {
+	return "value must be a valid RE2 regular expression"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Attribute %s %s: %s", req.Path, v.Description(ctx), err.Error()),
+		)
+	}
+}
+
+// isRegex returns a validator which ensures the value compiles as a regular expression.
+func isRegex() validator.String {
+	return regexValidator{}
+}
+
+// emailValidator checks that a string is a plain email address, without a display name.
+type emailValidator struct{}
+
+func (v emailValidator) Description(_ context.Context) string {
+	return "value must be an email address such as `someone@example.com`"
+}
+
+func (v emailValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v emailValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Name != "" || address.Address != value {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Email Address",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+// isEmail returns a validator which ensures the value is an email address.
+func isEmail() validator.String {
+	return emailValidator{}
+}
@@ -0,0 +1,70 @@
+package omglol
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/ejstreet/omglol-client-go/omglol"
+)
+
+// webPage is the profile web page of an address. The client library does not cover the web endpoints yet, so they
+// are called directly.
+type webPage struct {
+	Message string `json:"message"`
+	Content string `json:"content"`
+}
+
+func getWebPage(ctx context.Context, client *omglol.Client, address string) (*webPage, error) {
+	var p webPage
+	err := doAPIRequest(ctx, client, http.MethodGet, "/address/"+url.PathEscape(address)+"/web", nil, &p)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// setWebPage saves the profile web page content of an address. The page is only made public if publish is true.
+func setWebPage(ctx context.Context, client *omglol.Client, address string, content string, publish bool) error {
+	body := map[string]interface{}{"content": content, "publish": publish}
+	return doAPIRequest(ctx, client, http.MethodPost, "/address/"+url.PathEscape(address)+"/web", body, nil)
+}
+
+// templateVariableRegexp matches `${name}` references in web page sources. `$${` escapes a literal `${`.
+var templateVariableRegexp = regexp.MustCompile(`\$?\$\{([A-Za-z0-9_-]+)\}`)
+
+// renderTemplate replaces `${name}` references in source with the value of the variable. References to undefined
+// variables are returned as an error, so typos do not end up on the published page.
+func renderTemplate(source string, vars map[string]string) (string, error) {
+	var missing []string
+	rendered := templateVariableRegexp.ReplaceAllStringFunc(source, func(match string) string {
+		if strings.HasPrefix(match, "$$") {
+			return match[1:]
+		}
+
+		name := templateVariableRegexp.FindStringSubmatch(match)[1]
+		value, ok := vars[name]
+		if !ok {
+			missing = append(missing, name)
+			return match
+		}
+		return value
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("undefined template variables: %s", strings.Join(missing, ", "))
+	}
+
+	return rendered, nil
+}
+
+// contentSHA256 returns the hex encoded SHA-256 hash of content.
+func contentSHA256(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}